@@ -8,15 +8,19 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // the image is built from scratch, without a time zone database
 
 	"github.com/EyzRyder/Travel-Planner/internal/api"
 	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+	"github.com/EyzRyder/Travel-Planner/internal/auth"
 	"github.com/EyzRyder/Travel-Planner/internal/mailpit"
+	"github.com/EyzRyder/Travel-Planner/internal/outbox"
 
-	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/phenpessoa/gutils/netutils/httputils"
@@ -81,11 +85,45 @@ func run(ctx context.Context) error {
 		return err
 	}
 
-	si := api.NewAPI(pool, logger, mailpit.NewMailpit(pool))
+	secret := os.Getenv("JOURNEY_AUTH_SECRET")
+	if secret == "" {
+		return errors.New("JOURNEY_AUTH_SECRET must be set to sign access tokens")
+	}
+	signer := auth.NewSigner([]byte(secret), auth.DefaultTTL)
+
+	mailCfg, err := mailConfig()
+	if err != nil {
+		return err
+	}
+	mailer, err := mailpit.NewMailpit(pool, signer, mailCfg)
+	if err != nil {
+		return err
+	}
+
+	// The worker is stopped once the server is done with its requests and
+	// before the pool is closed. E-mails still queued then are sent on the
+	// next start.
+	worker := outbox.NewWorker(pool, mailer, logger, outbox.DefaultConfig())
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	workerDone := make(chan struct{})
+	go func() {
+		defer close(workerDone)
+		worker.Run(workerCtx)
+	}()
+	defer func() {
+		stopWorker()
+		<-workerDone
+	}()
+
+	si := api.NewAPI(pool, logger, signer, mailCfg.BaseURL)
 
 	r := chi.NewMux()
 	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
-	r.Mount("/", spec.Handler(&si))
+	r.Mount("/", spec.Handler(&si,
+		spec.WithErrorHandler(api.HandleParamError),
+		spec.WithAuthMiddleware(si.Authenticate),
+		spec.WithInboundMiddleware(api.AuthenticateInbound(os.Getenv("JOURNEY_INBOUND_SECRET"))),
+	))
 
 	srv := &http.Server{
 		Addr:         ":8080",
@@ -122,3 +160,50 @@ func run(ctx context.Context) error {
 	}
 	return nil
 }
+
+// mailConfig reads the SMTP server e-mails are sent through from the
+// JOURNEY_SMTP_* variables, and the URL their links point to from
+// JOURNEY_PUBLIC_URL. Those left empty keep the Mailpit defaults.
+func mailConfig() (mailpit.Config, error) {
+	cfg := mailpit.DefaultConfig()
+
+	for name, field := range map[string]*string{
+		"JOURNEY_SMTP_HOST":     &cfg.Host,
+		"JOURNEY_SMTP_TLS":      &cfg.TLS,
+		"JOURNEY_SMTP_AUTH":     &cfg.Auth,
+		"JOURNEY_SMTP_USERNAME": &cfg.Username,
+		"JOURNEY_SMTP_PASSWORD": &cfg.Password,
+		"JOURNEY_SMTP_FROM":     &cfg.From,
+		"JOURNEY_PUBLIC_URL":    &cfg.BaseURL,
+	} {
+		if value := os.Getenv(name); value != "" {
+			*field = value
+		}
+	}
+
+	if port := os.Getenv("JOURNEY_SMTP_PORT"); port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return cfg, fmt.Errorf("JOURNEY_SMTP_PORT must be a number: %w", err)
+		}
+		cfg.Port = p
+	}
+
+	if concurrency := os.Getenv("JOURNEY_SMTP_CONCURRENCY"); concurrency != "" {
+		n, err := strconv.Atoi(concurrency)
+		if err != nil {
+			return cfg, fmt.Errorf("JOURNEY_SMTP_CONCURRENCY must be a number: %w", err)
+		}
+		cfg.Concurrency = n
+	}
+
+	if rate := os.Getenv("JOURNEY_SMTP_RATE_LIMIT"); rate != "" {
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("JOURNEY_SMTP_RATE_LIMIT must be a number: %w", err)
+		}
+		cfg.RateLimit = r
+	}
+
+	return cfg, nil
+}
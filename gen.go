@@ -1,5 +1,5 @@
 package main
 
-//go:generate goapi-gen --package=spec --out ./internal/api/spec/journey.spec.go ./internal/api/spec/journey.spec.json run go generate ./... | run go generate
+//go:generate goapi-gen --package=spec --templates ./internal/api/spec/templates --out ./internal/api/spec/journey.spec.go ./internal/api/spec/journey.spec.json run go generate ./... | run go generate
 //go:generate tern migrate --migrations ./internal/pgstore/migrations --config ./internal/pgstore/migrations/tern.conf run go generate ./... | run go generate
 //go:generate sqlc generate -f ./internal/pgstore/sqlc.yml
@@ -4,20 +4,23 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"net/mail"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+	"github.com/EyzRyder/Travel-Planner/internal/auth"
+	"github.com/EyzRyder/Travel-Planner/internal/ical"
 	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
 
 	openapi_types "github.com/discord-gophers/goapi-gen/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
-    "github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
@@ -25,25 +28,34 @@ import (
 
 type Store interface {
 	GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
-	ConfirmParticipant(ctx context.Context, participantID uuid.UUID) error
+	GetParticipantByEmail(ctx context.Context, params pgstore.GetParticipantByEmailParams) (pgstore.Participant, error)
 	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
-	InviteParticipantToTrip(ctx context.Context, params pgstore.InviteParticipantToTripParams) (uuid.UUID, error)
+	GetParticipantsByRSVP(ctx context.Context, params pgstore.GetParticipantsByRSVPParams) ([]pgstore.Participant, error)
+	UpdateParticipantRole(ctx context.Context, params pgstore.UpdateParticipantRoleParams) error
+	UpdateParticipantProfile(ctx context.Context, params pgstore.UpdateParticipantProfileParams) error
 
 	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest) (uuid.UUID, error)
+	WithEmails(context.Context, *pgxpool.Pool, func(*pgstore.Queries) ([]pgstore.EnqueueEmailParams, error)) error
 	GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error)
-	UpdateTrip(ctx context.Context, params pgstore.UpdateTripParams) error
+	GetTripCounts(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripCountsRow, error)
+	DeleteTrip(ctx context.Context, id uuid.UUID) (int64, error)
+	CountActivitiesOutsideRange(ctx context.Context, params pgstore.CountActivitiesOutsideRangeParams) (int64, error)
 
 	CreateActivity(ctx context.Context, params pgstore.CreateActivityParams) (uuid.UUID, error)
-	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	CreateActivities(context.Context, *pgxpool.Pool, []pgstore.CreateActivityParams) ([]uuid.UUID, error)
+	GetTripActivities(ctx context.Context, params pgstore.GetTripActivitiesParams) ([]pgstore.Activity, error)
+	GetTripActivity(ctx context.Context, params pgstore.GetTripActivityParams) (pgstore.Activity, error)
+	GetOverlappingActivities(ctx context.Context, params pgstore.GetOverlappingActivitiesParams) ([]pgstore.Activity, error)
+	UpdateTripActivity(ctx context.Context, params pgstore.UpdateTripActivityParams) (pgstore.Activity, error)
+	DeleteTripActivity(ctx context.Context, params pgstore.DeleteTripActivityParams) (int64, error)
+	GetTripActivityExceptions(ctx context.Context, tripID uuid.UUID) ([]pgstore.ActivityException, error)
+	UpsertActivityException(ctx context.Context, params pgstore.UpsertActivityExceptionParams) error
 
 	CreateTripLink(ctx context.Context, params pgstore.CreateTripLinkParams) (uuid.UUID, error)
 	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error)
-}
-
-type Mailer interface {
-	SendConfirmTripEmailToTripOwner(uuid.UUID) error
-	SendTripConfirmedEmails(tripID uuid.UUID) error
-	SendTripConfirmedEmail(tripID, participantID uuid.UUID) error
+	GetTripLink(ctx context.Context, params pgstore.GetTripLinkParams) (pgstore.Link, error)
+	UpdateTripLink(ctx context.Context, params pgstore.UpdateTripLinkParams) (pgstore.Link, error)
+	DeleteTripLink(ctx context.Context, params pgstore.DeleteTripLinkParams) (int64, error)
 }
 
 type API struct {
@@ -51,438 +63,2309 @@ type API struct {
 	logger    *zap.Logger // us.logger do stander lib tambem serve
 	validator *validator.Validate
 	pool      *pgxpool.Pool
-	mailer    Mailer
+	signer    auth.Signer
+	// baseURL is the public URL of the API, which the URLs it returns
+	// point to.
+	baseURL string
 }
 
-func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, mailer Mailer) API {
+func NewAPI(pool *pgxpool.Pool, logger *zap.Logger, signer auth.Signer, baseURL string) API {
 	validator := validator.New(validator.WithRequiredStructEnabled())
-	return API{pgstore.New(pool), logger, validator, pool, mailer}
+	validator.RegisterTagNameFunc(jsonTagName)
+	return API{pgstore.New(pool), logger, validator, pool, signer, baseURL}
 }
 
-// Confirms a participant on a trip.
-// (PATCH /participants/{participantId}/confirm)
-func (ap *API) PatchParticipantsParticipantIDConfirm(
+// Remove a participant from a trip.
+// (DELETE /participants/{participantId})
+func (ap *API) DeleteParticipantsParticipantID(
 	w http.ResponseWriter,
 	r *http.Request,
 	participantID string,
 ) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.DeleteParticipantsParticipantIDJSON404Response,
+		internal: spec.DeleteParticipantsParticipantIDJSON500Response,
+	}
+
 	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return spec.DeleteParticipantsParticipantIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
 		)
 	}
 
 	participant, err := ap.store.GetParticipant(r.Context(), id)
-
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(
-				spec.Error{Message: "trip or participant not found"},
-			)
-		}
-		ap.logger.Error(
+		return ap.storeError(r, errs, err, "participant not found",
 			"failed to get participant",
-			zap.Error(err),
 			zap.String("participant_id", participantID),
 		)
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(
-			spec.Error{
-				Message: "something went wrong, try again",
-			})
 	}
 
-	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(
-			spec.Error{
-				Message: "participant already confirmed",
-			})
+	if !canActOnTrip(r, participant.TripID) {
+		return spec.DeleteParticipantsParticipantIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
 	}
-	if err := ap.store.ConfirmParticipant(r.Context(), id); err != nil {
-		ap.logger.Error(
-			"failed to confim participant",
-			zap.Error(err),
+
+	if err := ap.store.WithEmails(r.Context(), ap.pool, func(qtx *pgstore.Queries) ([]pgstore.EnqueueEmailParams, error) {
+		if err := qtx.DeleteParticipant(r.Context(), id); err != nil {
+			return nil, err
+		}
+		return []pgstore.EnqueueEmailParams{pgstore.ParticipantRemovedEmail(participant.TripID, participant.Email)}, nil
+	}); err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to delete participant",
 			zap.String("participant_id", participantID),
 		)
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(
-			spec.Error{
-				Message: "something went wrong, try again",
-			})
 	}
 
-	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+	return spec.DeleteParticipantsParticipantIDJSON204Response(nil)
 }
 
-// Create a new trip
-// (POST /trips)
-func (ap *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
-	var body spec.CreateTripRequest
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid JSON: " + err.Error()})
-	}
-
-	if err := ap.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
-	}
-
-	tripID, err := ap.store.CreateTrip(r.Context(), ap.pool, body)
+// Asks a participant to confirm from the link of their invitation.
+// (GET /participants/{participantId}/confirm)
+func (ap *API) GetParticipantsParticipantIDConfirm(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	locale := pageLocale(r, ap.participantPageLocale(r, participantID))
 
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "failed to create trip, try again"})
+		ap.renderLanding(w, locale, participantConfirmPages, spec.PatchParticipantsParticipantIDConfirmJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		), "")
+		return nil
+	}
+	if !canActAsParticipant(r, id) {
+		ap.renderLanding(w, locale, participantConfirmPages, spec.PatchParticipantsParticipantIDConfirmJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued to this participant"),
+		), "")
+		return nil
 	}
 
-	go func() {
-		if err := ap.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
-			ap.logger.Error(
-				"failed to send email on PostTrips",
-				zap.Error(err),
-				zap.String("trip_id", tripID.String()),
-			)
-		}
-	}()
-
-	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
+	ap.renderPrompt(w, r, locale, participantConfirmPrompts, ap.profileURL(r, participantID))
+	return nil
 }
 
-// Get a trip details.
-// (GET /trips/{tripId})
-func (ap *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
+// Confirms a participant from the page of their invitation link.
+// (POST /participants/{participantId}/confirm)
+func (ap *API) PostParticipantsParticipantIDConfirm(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	resp := ap.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+	locale := pageLocale(r, ap.participantPageLocale(r, participantID))
+	ap.renderLanding(w, locale, participantConfirmPages, resp, ap.profileURL(r, participantID))
+	return nil
 }
 
-// Update a trip.
-// (PUT /trips/{tripId})
-func (ap *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	panic("not implemented") // TODO: Implement
-}
+// Returns the profile of a participant.
+// (GET /participants/{participantId}/profile)
+func (ap *API) GetParticipantsParticipantIDProfile(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetParticipantsParticipantIDProfileJSON404Response,
+		internal: spec.GetParticipantsParticipantIDProfileJSON500Response,
+	}
 
-// Get a trip activities.
-// (GET /trips/{tripId}/activities)
-func (ap *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return spec.GetParticipantsParticipantIDProfileJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
 		)
 	}
 
-	activities, err := ap.store.GetTripActivities(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDActivitiesJSON400Response(
-				spec.Error{Message: "trip not found"},
-			)
-		}
-		ap.logger.Error(
-			"failed to find trip participants",
-			zap.Error(err),
-			zap.String("trip_id", tripID),
+	if !canActAsParticipant(r, id) {
+		return spec.GetParticipantsParticipantIDProfileJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued to this participant"),
 		)
-		return spec.GetTripsTripIDActivitiesJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
+	}
+
+	participant, err := ap.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to get participant",
+			zap.String("participant_id", participantID),
 		)
 	}
 
-	var output spec.GetTripActivitiesResponse
+	return spec.GetParticipantsParticipantIDProfileJSON200Response(participantResponse(participant, true))
+}
 
-	groupedActivites := make(map[string][]pgstore.Activity)
+// Confirms a participant on a trip.
+// (PATCH /participants/{participantId}/confirm)
+func (ap *API) PatchParticipantsParticipantIDConfirm(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PatchParticipantsParticipantIDConfirmJSON404Response,
+		conflict: spec.PatchParticipantsParticipantIDConfirmJSON409Response,
+		internal: spec.PatchParticipantsParticipantIDConfirmJSON500Response,
+	}
 
-	for _, act := range activities {
-		date := act.OccursAt.Time.Format(time.DateOnly)
-		groupedActivites[date] = append(groupedActivites[date], act)
+	id, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
 	}
 
-	for dateStr, actsOnDate := range groupedActivites {
-		var innerActs []spec.GetTripActivitiesResponseInnerArray
+	if !canActAsParticipant(r, id) {
+		return spec.PatchParticipantsParticipantIDConfirmJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued to this participant"),
+		)
+	}
 
-		for _, act := range actsOnDate {
-			innerActs = append(innerActs,
-				spec.GetTripActivitiesResponseInnerArray{
-					ID:       act.ID.String(),
-					OccursAt: act.OccursAt.Time,
-					Title:    act.Title,
-				})
-		}
+	participant, err := ap.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip or participant not found",
+			"failed to get participant",
+			zap.String("participant_id", participantID),
+		)
+	}
 
-		date, _ := time.Parse(time.DateOnly, dateStr)
-		output.Activities = append(output.Activities,
-			spec.GetTripActivitiesResponseOuterArray{
-				Date:       date,
-				Activities: innerActs,
-			})
+	switch participant.RsvpStatus {
+	case pgstore.RsvpStatusAccepted:
+		return errs.conflict(newError(r, spec.ErrorCodeConflict, "participant already confirmed"))
+	case pgstore.RsvpStatusRemoved:
+		return errs.conflict(newError(r, spec.ErrorCodeConflict, "participant was removed from the trip"))
 	}
 
-	return spec.GetTripsTripIDActivitiesJSON200Response(output)
+	if err := ap.changeRSVP(r.Context(), participant.TripID, id, func(qtx *pgstore.Queries) error {
+		return qtx.ConfirmParticipant(r.Context(), id)
+	}); err != nil {
+		return ap.storeError(r, errs, err, "trip or participant not found",
+			"failed to confim participant",
+			zap.String("participant_id", participantID),
+		)
+	}
+
+	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
 }
 
-// Create a trip activity.
-// (POST /trips/{tripId}/activities)
-func (ap *API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+// Changes the RSVP of a participant.
+// (PUT /participants/{participantId}/rsvp)
+func (ap *API) PutParticipantsParticipantIDRsvp(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PutParticipantsParticipantIDRsvpJSON404Response,
+		internal: spec.PutParticipantsParticipantIDRsvpJSON500Response,
+	}
+
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return spec.PutParticipantsParticipantIDRsvpJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
 		)
 	}
 
-	var body spec.PostTripsTripIDActivitiesJSONBody
+	var body spec.UpdateRSVPRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(
-			spec.Error{Message: err.Error()},
+		return spec.PutParticipantsParticipantIDRsvpJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
 		)
 	}
 
-	activityID, err := ap.store.CreateActivity(r.Context(),
-		pgstore.CreateActivityParams{
-			TripID:   id,
-			Title:    body.Title,
-			OccursAt: pgtype.Timestamp{Time: body.OccursAt, Valid: true},
-		},
-	)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDActivitiesJSON400Response(
-				spec.Error{Message: "trip not found"},
-			)
-		}
-		ap.logger.Error(
-			"failed to find trip participants",
-			zap.Error(err),
-			zap.String("trip_id", tripID),
-		)
-		return spec.PostTripsTripIDActivitiesJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
-		)
+	if body.Status == spec.UnknownRSVPStatus {
+		return spec.PutParticipantsParticipantIDRsvpJSON400Response(fieldError(r,
+			"status", "required", "is required",
+		))
 	}
 
-	return spec.PostTripsTripIDActivitiesJSON201Response(
-		spec.CreateActivityResponse{ActivityID: activityID.String()},
-	)
-}
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PutParticipantsParticipantIDRsvpJSON400Response(validationError(r, err))
+	}
 
-// Confirm a trip and send e-mail invitations.
-// (GET /trips/{tripId}/confirm)
-func (ap *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+	participant, err := ap.store.GetParticipant(r.Context(), id)
 	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to get participant",
+			zap.String("participant_id", participantID),
 		)
 	}
 
-	trip, err := ap.store.GetTrip(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDConfirmJSON400Response(
-				spec.Error{Message: "trip not found"},
+	// Participants answer their own invitation, while inviting someone
+	// again or removing them from the trip is up to the organizers.
+	switch body.Status {
+	case spec.RSVPStatusAccepted, spec.RSVPStatusDeclined, spec.RSVPStatusMaybe:
+		if !canActAsParticipant(r, id) {
+			return spec.PutParticipantsParticipantIDRsvpJSON403Response(
+				newError(r, spec.ErrorCodeForbidden, "access token not issued to this participant"),
 			)
 		}
-		ap.logger.Error(
-			"failed to get trip by id",
-			zap.Error(err),
-			zap.String("trip_id", tripID),
-		)
-		return spec.GetTripsTripIDConfirmJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
-		)
-	}
 
-	if trip.IsConfirmed {
-		return spec.GetTripsTripIDConfirmJSON400Response(
-			spec.Error{Message: "trip already confirmed"},
-		)
+		if participant.RsvpStatus == pgstore.RsvpStatusRemoved {
+			return spec.PutParticipantsParticipantIDRsvpJSON403Response(
+				newError(r, spec.ErrorCodeForbidden, "participant was removed from the trip"),
+			)
+		}
+	default:
+		ok, err := ap.authorize(r, participant.TripID, organizerRoles)
+		if err != nil {
+			return ap.storeError(r, errs, err, "participant not found",
+				"failed to authorize participant",
+				zap.String("participant_id", participantID),
+			)
+		}
+
+		if !ok {
+			return spec.PutParticipantsParticipantIDRsvpJSON403Response(
+				newError(r, spec.ErrorCodeForbidden, "only organizers can invite or remove participants"),
+			)
+		}
 	}
 
-	if err := ap.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
-		Destination: trip.Destination,
-		EndsAt:      trip.EndsAt,
-		StartsAt:    trip.StartsAt,
-		IsConfirmed: trip.IsConfirmed,
-		ID:          id,
+	if err := ap.changeRSVP(r.Context(), participant.TripID, id, func(qtx *pgstore.Queries) error {
+		return qtx.SetParticipantRSVP(r.Context(), pgstore.SetParticipantRSVPParams{
+			ID:         id,
+			RsvpStatus: pgstore.RsvpStatus(body.Status.ToValue()),
+			RsvpNote:   toText(body.Note),
+		})
 	}); err != nil {
-		ap.logger.Error(
-			"failed to update trip",
-			zap.Error(err),
-			zap.String("trip_id", tripID),
-		)
-		return spec.GetTripsTripIDConfirmJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to update participant rsvp",
+			zap.String("participant_id", participantID),
 		)
 	}
 
-	go func() {
-		if err := ap.mailer.SendTripConfirmedEmails(id); err != nil {
-			ap.logger.Error("failed to send trip confirmed email", zap.Error(err))
+	return spec.PutParticipantsParticipantIDRsvpJSON204Response(nil)
+}
+
+// changeRSVP runs change, which updates the RSVP of participantID, and
+// queues the e-mail letting the trip owner know about it in the same
+// transaction.
+func (ap *API) changeRSVP(ctx context.Context, tripID, participantID uuid.UUID, change func(*pgstore.Queries) error) error {
+	return ap.store.WithEmails(ctx, ap.pool, func(qtx *pgstore.Queries) ([]pgstore.EnqueueEmailParams, error) {
+		if err := change(qtx); err != nil {
+			return nil, err
 		}
-	}()
+		return []pgstore.EnqueueEmailParams{
+			pgstore.ParticipantEmail(pgstore.EmailKindRsvpChanged, tripID, participantID),
+		}, nil
+	})
+}
 
-	return spec.GetTripsTripIDConfirmJSON204Response(nil)
+// replyStatuses maps the participation statuses an attendee can reply with
+// to the RSVP they stand for.
+var replyStatuses = map[string]pgstore.RsvpStatus{
+	ical.PartStatAccepted:  pgstore.RsvpStatusAccepted,
+	ical.PartStatDeclined:  pgstore.RsvpStatusDeclined,
+	ical.PartStatTentative: pgstore.RsvpStatusMaybe,
 }
 
-// Invite someone to the trip.
-// (POST /trips/{tripId}/invites)
-func (ap *API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+// Apply an iTIP reply to a trip invitation.
+// (POST /calendar/replies)
+func (ap *API) PostCalendarReplies(w http.ResponseWriter, r *http.Request) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PostCalendarRepliesJSON404Response,
+		internal: spec.PostCalendarRepliesJSON500Response,
+	}
+
+	cal, err := ical.Decode(http.MaxBytesReader(w, r.Body, maxCalendarSize), nil)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return spec.PostCalendarRepliesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid calendar: "+err.Error()),
 		)
 	}
 
-	var body spec.PostTripsTripIDInvitesJSONBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(
-			spec.Error{Message: err.Error()},
+	// A reply holds the event being answered with the attendee answering.
+	if cal.Method != ical.MethodReply || len(cal.Events) != 1 || len(cal.Events[0].Attendees) != 1 {
+		return spec.PostCalendarRepliesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "calendar is not a reply with a single event and attendee"),
 		)
 	}
+	event := cal.Events[0]
+	attendee := event.Attendees[0]
 
-	participantID, err := ap.store.InviteParticipantToTrip(r.Context(), pgstore.InviteParticipantToTripParams{
-		TripID: id,
-		Email:  string(body.Email),
+	tripID, ok := pgstore.TripIDFromCalendarUID(event.UID)
+	if !ok {
+		return errs.notFound(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+	}
+
+	status, ok := replyStatuses[attendee.PartStat]
+	if !ok {
+		return spec.PostCalendarRepliesJSON400Response(fieldError(r,
+			"PARTSTAT", "oneof", "must be one of ACCEPTED, DECLINED or TENTATIVE",
+		))
+	}
+
+	participant, err := ap.store.GetParticipantByEmail(r.Context(), pgstore.GetParticipantByEmailParams{
+		TripID: tripID,
+		Email:  attendee.Email,
 	})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "trip not found"})
-		}
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) {
-			if pgErr.Code == pgerrcode.UniqueViolation {
-				return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "participant already invited"})
-			}
-		}
-		ap.logger.Error(
-			"failed to invite participant to trip",
-			zap.Error(err),
-			zap.String("trip_id", tripID),
-			zap.String("participant_email", string(body.Email)),
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to get participant by email",
+			zap.String("trip_id", tripID.String()),
 		)
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "something went wrong, try again"})
 	}
 
-	go func() {
-		if err := ap.mailer.SendTripConfirmedEmail(id, participantID); err != nil {
-			ap.logger.Error(
-				"failed to send trip confirmed email",
-				zap.Error(err),
-				zap.String("participant_id", participantID.String()),
-				zap.String("trip_id", tripID),
-			)
-		}
-	}()
+	if participant.RsvpStatus == pgstore.RsvpStatusRemoved {
+		return spec.PostCalendarRepliesJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "participant was removed from the trip"),
+		)
+	}
 
-	return spec.PostTripsTripIDInvitesJSON201Response(nil)
+	if err := ap.changeRSVP(r.Context(), tripID, participant.ID, func(qtx *pgstore.Queries) error {
+		return qtx.SetParticipantRSVP(r.Context(), pgstore.SetParticipantRSVPParams{
+			ID:         participant.ID,
+			RsvpStatus: status,
+		})
+	}); err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to update participant rsvp",
+			zap.String("participant_id", participant.ID.String()),
+		)
+	}
 
+	return spec.PostCalendarRepliesJSON204Response(nil)
 }
 
-// Get a trip links.
-// (GET /trips/{tripId}/links)
-func (ap *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+// Updates the profile of a participant.
+// (PUT /participants/{participantId}/profile)
+func (ap *API) PutParticipantsParticipantIDProfile(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PutParticipantsParticipantIDProfileJSON404Response,
+		internal: spec.PutParticipantsParticipantIDProfileJSON500Response,
+	}
+
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return spec.PutParticipantsParticipantIDProfileJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
 		)
 	}
 
-	links, err := ap.store.GetTripLinks(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDLinksJSON400Response(
-				spec.Error{Message: "trip not found"},
-			)
-		}
-		ap.logger.Error(
-			"failed to find trip links",
-			zap.Error(err),
-			zap.String("trip_id", tripID),
+	if !canActAsParticipant(r, id) {
+		return spec.PutParticipantsParticipantIDProfileJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued to this participant"),
 		)
-		return spec.GetTripsTripIDLinksJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
+	}
+
+	var body spec.UpdateParticipantProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutParticipantsParticipantIDProfileJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
 		)
 	}
 
-	var output spec.GetLinksResponse
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PutParticipantsParticipantIDProfileJSON400Response(validationError(r, err))
+	}
 
-	for _, link := range links {
-		output.Links = append(output.Links, spec.GetLinksResponseArray{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		})
+	if _, err := ap.store.GetParticipant(r.Context(), id); err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to get participant",
+			zap.String("participant_id", participantID),
+		)
 	}
 
-	return spec.GetTripsTripIDLinksJSON200Response(output)
+	if err := ap.store.UpdateParticipantProfile(r.Context(), pgstore.UpdateParticipantProfileParams{
+		ID:                 id,
+		Name:               pgtype.Text{String: body.Name, Valid: true},
+		Phone:              toText(body.Phone),
+		DietaryNotes:       toText(body.DietaryNotes),
+		AccessibilityNotes: toText(body.AccessibilityNotes),
+		Locale:             toLocale(body.Locale),
+	}); err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to update participant profile",
+			zap.String("participant_id", participantID),
+		)
+	}
+
+	return spec.PutParticipantsParticipantIDProfileJSON204Response(nil)
 }
 
-// Create a trip link.
-// (POST /trips/{tripId}/links)
-func (ap *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+// Changes the role of a participant on a trip.
+// (PATCH /participants/{participantId}/role)
+func (ap *API) PatchParticipantsParticipantIDRole(
+	w http.ResponseWriter,
+	r *http.Request,
+	participantID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PatchParticipantsParticipantIDRoleJSON404Response,
+		internal: spec.PatchParticipantsParticipantIDRoleJSON500Response,
+	}
+
+	id, err := uuid.Parse(participantID)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+		return spec.PatchParticipantsParticipantIDRoleJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
 		)
 	}
 
-	var body spec.PostTripsTripIDLinksJSONBody
+	var body spec.PatchParticipantsParticipantIDRoleJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(
-			spec.Error{Message: err.Error()},
+		return spec.PatchParticipantsParticipantIDRoleJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
 		)
 	}
 
-	linkID, err := ap.store.CreateTripLink(r.Context(),
-		pgstore.CreateTripLinkParams{
-			TripID: id,
-			Title:  body.Title,
-			Url:    body.URL,
-		})
+	if body.Role == spec.UnknownParticipantRole {
+		return spec.PatchParticipantsParticipantIDRoleJSON400Response(fieldError(r,
+			"role", "required", "is required",
+		))
+	}
+
+	participant, err := ap.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to get participant",
+			zap.String("participant_id", participantID),
+		)
+	}
+
+	if !canActOnTrip(r, participant.TripID) {
+		return spec.PatchParticipantsParticipantIDRoleJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	if err := ap.store.UpdateParticipantRole(r.Context(), pgstore.UpdateParticipantRoleParams{
+		ID:   id,
+		Role: pgstore.ParticipantRole(body.Role.ToValue()),
+	}); err != nil {
+		return ap.storeError(r, errs, err, "participant not found",
+			"failed to update participant role",
+			zap.String("participant_id", participantID),
+		)
+	}
+
+	return spec.PatchParticipantsParticipantIDRoleJSON204Response(nil)
+}
+
+// Create a new trip
+// (POST /trips)
+func (ap *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
+	errs := errorResponses{
+		internal: spec.PostTripsJSON500Response,
+	}
+
+	var body spec.CreateTripRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()))
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PostTripsJSON400Response(validationError(r, err))
+	}
+
+	if !body.EndsAt.After(body.StartsAt) {
+		return spec.PostTripsJSON400Response(fieldError(r,
+			"ends_at", "gtfield", "must be after starts_at",
+		))
+	}
+
+	tripID, err := ap.store.CreateTrip(r.Context(), ap.pool, body)
+	if err != nil {
+		return ap.storeError(r, errs, err, "", "failed to create trip")
+	}
+
+	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
+}
+
+// Get a trip details.
+// (GET /trips/{tripId})
+func (ap *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDJSON404Response,
+		internal: spec.GetTripsTripIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	counts, err := ap.store.GetTripCounts(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip counts",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
+		Trip: spec.GetTripDetailsResponseTripObj{
+			ID:                         trip.ID.String(),
+			Destination:                trip.Destination,
+			StartsAt:                   trip.StartsAt.Time.UTC(),
+			EndsAt:                     trip.EndsAt.Time.UTC(),
+			TimeZone:                   trip.TimeZone,
+			Locale:                     locale(trip.Locale),
+			StartsAtLocal:              trip.StartsAt.Time.In(trip.Zone()),
+			EndsAtLocal:                trip.EndsAt.Time.In(trip.Zone()),
+			IsConfirmed:                trip.IsConfirmed,
+			OwnerName:                  trip.OwnerName,
+			OwnerEmail:                 openapi_types.Email(trip.OwnerEmail),
+			ParticipantsCount:          counts.ParticipantsCount,
+			ConfirmedParticipantsCount: counts.ConfirmedParticipantsCount,
+			ActivitiesCount:            counts.ActivitiesCount,
+			LinksCount:                 counts.LinksCount,
+		},
+	})
+}
+
+// Update a trip.
+// (PUT /trips/{tripId})
+func (ap *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PutTripsTripIDJSON404Response,
+		conflict: spec.PutTripsTripIDJSON409Response,
+		internal: spec.PutTripsTripIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, organizerRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PutTripsTripIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "only organizers can update the trip"),
+		)
+	}
+
+	var body spec.UpdateTripRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDJSON400Response(newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()))
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDJSON400Response(validationError(r, err))
+	}
+
+	if !body.EndsAt.After(body.StartsAt) {
+		return spec.PutTripsTripIDJSON400Response(fieldError(r,
+			"ends_at", "gtfield", "must be after starts_at",
+		))
+	}
+
+	startsAt := pgtype.Timestamptz{Time: body.StartsAt, Valid: true}
+	endsAt := pgtype.Timestamptz{Time: body.EndsAt, Valid: true}
+
+	// The trip row is locked before activities are counted, which holds
+	// back the activities being added or moved in the meantime until the
+	// new dates are saved, since their foreign key shares the row.
+	var outside int64
+	if err := ap.store.WithEmails(r.Context(), ap.pool, func(qtx *pgstore.Queries) ([]pgstore.EnqueueEmailParams, error) {
+		trip, err := qtx.GetTripForUpdate(r.Context(), id)
+		if err != nil {
+			return nil, err
+		}
+
+		outside, err = qtx.CountActivitiesOutsideRange(r.Context(), pgstore.CountActivitiesOutsideRangeParams{
+			TripID:   id,
+			StartsAt: startsAt,
+			EndsAt:   endsAt,
+		})
+		if err != nil || outside > 0 {
+			return nil, err
+		}
+
+		timeZone := trip.TimeZone
+		if body.TimeZone != nil {
+			timeZone = *body.TimeZone
+		}
+
+		tripLocale := trip.Locale
+		if body.Locale != nil {
+			tripLocale = pgstore.Locale(body.Locale.ToValue())
+		}
+
+		changed := trip.Destination != body.Destination ||
+			!trip.StartsAt.Time.Equal(body.StartsAt) ||
+			!trip.EndsAt.Time.Equal(body.EndsAt) ||
+			trip.TimeZone != timeZone
+
+		if err := qtx.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
+			Destination: body.Destination,
+			EndsAt:      endsAt,
+			StartsAt:    startsAt,
+			IsConfirmed: trip.IsConfirmed,
+			TimeZone:    timeZone,
+			Locale:      tripLocale,
+			ID:          id,
+		}); err != nil || !changed {
+			return nil, err
+		}
+		return []pgstore.EnqueueEmailParams{pgstore.TripEmail(pgstore.EmailKindTripUpdated, id)}, nil
+	}); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to update trip",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	if outside > 0 {
+		return errs.conflict(newError(r, spec.ErrorCodeConflict,
+			fmt.Sprintf("%d activities would fall outside the new trip dates", outside),
+		))
+	}
+
+	return spec.PutTripsTripIDJSON204Response(nil)
+}
+
+// Delete a trip with its participants, activities and links.
+// (DELETE /trips/{tripId})
+func (ap *API) DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.DeleteTripsTripIDJSON404Response,
+		internal: spec.DeleteTripsTripIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	if !canActOnTrip(r, id) {
+		return spec.DeleteTripsTripIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	// Participants, activities and links are removed by the ON DELETE
+	// CASCADE foreign keys.
+	deleted, err := ap.store.DeleteTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to delete trip",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	if deleted == 0 {
+		return errs.notFound(newError(r, spec.ErrorCodeNotFound, "trip not found"))
+	}
+
+	return spec.DeleteTripsTripIDJSON204Response(nil)
+}
+
+// Get a trip activities.
+// (GET /trips/{tripId}/activities)
+func (ap *API) GetTripsTripIDActivities(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	params spec.GetTripsTripIDActivitiesParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDActivitiesJSON404Response,
+		internal: spec.GetTripsTripIDActivitiesJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	for _, category := range params.Category {
+		var c spec.ActivityCategory
+		if err := c.FromValue(category); err != nil {
+			return spec.GetTripsTripIDActivitiesJSON400Response(fieldError(r,
+				"category", "oneof", "must be one of transport, lodging, food, sightseeing or other",
+			))
+		}
+	}
+
+	if params.From != nil && params.To != nil && params.To.Before(params.From.Time) {
+		return spec.GetTripsTripIDActivitiesJSON400Response(fieldError(r,
+			"to", "gtefield", "must not be before from",
+		))
+	}
+
+	// The dates are days in the trip time zone, so the filter goes from the
+	// start of from until the start of the day after to.
+	filter := pgstore.GetTripActivitiesParams{TripID: id, Categories: params.Category}
+	if params.From != nil {
+		filter.OccursFrom = pgtype.Timestamptz{Time: dateIn(*params.From, trip.Zone()), Valid: true}
+	}
+	if params.To != nil {
+		filter.OccursUntil = pgtype.Timestamptz{Time: dateIn(*params.To, trip.Zone()).AddDate(0, 0, 1), Valid: true}
+	}
+
+	activities, err := ap.store.GetTripActivities(r.Context(), filter)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	exceptions, err := ap.store.GetTripActivityExceptions(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activity exceptions",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	output := groupActivitiesByDay(trip, activities, exceptions, filter.OccursFrom, filter.OccursUntil)
+
+	return spec.GetTripsTripIDActivitiesJSON200Response(output)
+}
+
+// Create a trip activity.
+// (POST /trips/{tripId}/activities)
+func (ap *API) PostTripsTripIDActivities(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	params spec.PostTripsTripIDActivitiesParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PostTripsTripIDActivitiesJSON404Response,
+		conflict: spec.PostTripsTripIDActivitiesJSON409Response,
+		internal: spec.PostTripsTripIDActivitiesJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PostTripsTripIDActivitiesJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not add activities"),
+		)
+	}
+
+	var body spec.PostTripsTripIDActivitiesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	activity, ferr := ap.activityParams(r, trip, spec.CreateActivityRequest(body))
+	if ferr != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(*ferr)
+	}
+
+	warnings, err := ap.overlapWarnings(r, trip, createdActivity(activity))
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find overlapping activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	if params.Strict != nil && *params.Strict && len(warnings) > 0 {
+		return errs.conflict(overlapError(r, warnings))
+	}
+
+	activityID, err := ap.store.CreateActivity(r.Context(), activity)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to create trip activity",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(
+		spec.CreateActivityResponse{ActivityID: activityID.String(), Warnings: warnings},
+	)
+}
+
+// Create several trip activities at once.
+// (POST /trips/{tripId}/activities/bulk)
+func (ap *API) PostTripsTripIDActivitiesBulk(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	params spec.PostTripsTripIDActivitiesBulkParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PostTripsTripIDActivitiesBulkJSON404Response,
+		internal: spec.PostTripsTripIDActivitiesBulkJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesBulkJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PostTripsTripIDActivitiesBulkJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not add activities"),
+		)
+	}
+
+	var body spec.PostTripsTripIDActivitiesBulkJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDActivitiesBulkJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDActivitiesBulkJSON400Response(validationError(r, err))
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	existing, err := ap.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	exceptions, err := ap.store.GetTripActivityExceptions(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activity exceptions",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	changed := exceptionsByActivity(exceptions)
+	existingSpans := make([][]span, len(existing))
+	for i, act := range existing {
+		existingSpans[i] = activitySpans(trip, act, changed[act.ID])
+	}
+
+	// Activities are checked for overlaps against the ones of the trip and
+	// the ones sent before them, as if they were created one by one.
+	// Warnings about the latter get their IDs once they are created.
+	var (
+		output       = spec.CreateActivitiesResponse{Activities: make([]spec.CreatedActivity, len(body.Activities))}
+		accepted     []int
+		created      []pgstore.CreateActivityParams
+		createdSpans [][]span
+		sentWith     = make(map[int][]int)
+		failed       bool
+	)
+	for i, item := range body.Activities {
+		result := &output.Activities[i]
+		result.Warnings = []spec.ActivityWarning{}
+
+		activity, ferr := ap.activityParams(r, trip, item)
+		if ferr != nil {
+			result.Error, failed = ferr, true
+			continue
+		}
+
+		spans := activitySpans(trip, createdActivity(activity), nil)
+		for j, act := range existing {
+			if spansOverlap(spans, existingSpans[j]) {
+				result.Warnings = append(result.Warnings, overlapWarning(act.ID, act.Title))
+			}
+		}
+		var overlapping []int
+		for j, other := range createdSpans {
+			if spansOverlap(spans, other) {
+				overlapping = append(overlapping, j)
+			}
+		}
+
+		if params.Strict != nil && *params.Strict && len(result.Warnings)+len(overlapping) > 0 {
+			for _, j := range overlapping {
+				result.Warnings = append(result.Warnings, overlapWarning(uuid.Nil, created[j].Title))
+			}
+			e := overlapError(r, result.Warnings)
+			result.Error, result.Warnings, failed = &e, []spec.ActivityWarning{}, true
+			continue
+		}
+
+		sentWith[len(created)] = overlapping
+		accepted = append(accepted, i)
+		created = append(created, activity)
+		createdSpans = append(createdSpans, spans)
+	}
+
+	if failed && (params.Atomic == nil || *params.Atomic) {
+		for _, i := range accepted {
+			output.Activities[i].Warnings = []spec.ActivityWarning{}
+		}
+		return spec.PostTripsTripIDActivitiesBulkJSON422Response(output)
+	}
+
+	ids, err := ap.store.CreateActivities(r.Context(), ap.pool, created)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to create trip activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	for j, i := range accepted {
+		result := &output.Activities[i]
+		activityID := ids[j].String()
+		result.ActivityID = &activityID
+		for _, k := range sentWith[j] {
+			result.Warnings = append(result.Warnings, overlapWarning(ids[k], created[k].Title))
+		}
+	}
+
+	return spec.PostTripsTripIDActivitiesBulkJSON201Response(output)
+}
+
+// Import trip activities from an iCalendar file.
+// (POST /trips/{tripId}/activities/import)
+func (ap *API) PostTripsTripIDActivitiesImport(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	params spec.PostTripsTripIDActivitiesImportParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PostTripsTripIDActivitiesImportJSON404Response,
+		internal: spec.PostTripsTripIDActivitiesImportJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PostTripsTripIDActivitiesImportJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not add activities"),
+		)
+	}
+
+	body, err := calendarBody(w, r)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid upload: "+err.Error()),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	// Times without a time zone are taken as local times of the trip.
+	cal, err := ical.Decode(body, trip.Zone())
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid calendar: "+err.Error()),
+		)
+	}
+
+	existing, err := ap.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	activities, imported, skipped := importActivities(trip, existing, cal)
+	output := spec.ImportActivitiesResponse{
+		DryRun:     params.DryRun != nil && *params.DryRun,
+		Activities: imported,
+		Skipped:    skipped,
+	}
+
+	if output.DryRun {
+		return spec.PostTripsTripIDActivitiesImportJSON200Response(output)
+	}
+
+	ids, err := ap.store.CreateActivities(r.Context(), ap.pool, activities)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to import trip activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	for i, activityID := range ids {
+		s := activityID.String()
+		output.Activities[i].ActivityID = &s
+	}
+
+	return spec.PostTripsTripIDActivitiesImportJSON201Response(output)
+}
+
+// Get a trip activity.
+// (GET /trips/{tripId}/activities/{activityId})
+func (ap *API) GetTripsTripIDActivitiesActivityID(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	activityID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDActivitiesActivityIDJSON404Response,
+		internal: spec.GetTripsTripIDActivitiesActivityIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	act, err := ap.store.GetTripActivity(r.Context(), pgstore.GetTripActivityParams{
+		ID:     activityUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to get trip activity",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	return spec.GetTripsTripIDActivitiesActivityIDJSON200Response(
+		activityResponse(trip, act),
+	).Header("ETag", etag(act.Version))
+}
+
+// Update a trip activity.
+// (PATCH /trips/{tripId}/activities/{activityId})
+func (ap *API) PatchTripsTripIDActivitiesActivityID(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	activityID string,
+	params spec.PatchTripsTripIDActivitiesActivityIDParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PatchTripsTripIDActivitiesActivityIDJSON404Response,
+		conflict: spec.PatchTripsTripIDActivitiesActivityIDJSON409Response,
+		internal: spec.PatchTripsTripIDActivitiesActivityIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not edit activities"),
+		)
+	}
+
+	if params.IfMatch == nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON428Response(
+			newError(r, spec.ErrorCodePreconditionRequired, "If-Match header is required"),
+		)
+	}
+
+	var body spec.UpdateActivityRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(validationError(r, err))
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	current, err := ap.store.GetTripActivity(r.Context(), pgstore.GetTripActivityParams{
+		ID:     activityUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to get trip activity",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	if !matchesETag(*params.IfMatch, current.Version) {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON412Response(
+			newError(r, spec.ErrorCodePreconditionFailed, "activity was changed since it was read"),
+		).Header("ETag", etag(current.Version))
+	}
+
+	update := pgstore.UpdateTripActivityParams{
+		ID:               current.ID,
+		TripID:           current.TripID,
+		Title:            current.Title,
+		OccursAt:         current.OccursAt,
+		EndsAt:           current.EndsAt,
+		TimeZone:         current.TimeZone,
+		Location:         current.Location,
+		Category:         current.Category,
+		Address:          current.Address,
+		Latitude:         current.Latitude,
+		Longitude:        current.Longitude,
+		BookingReference: current.BookingReference,
+		CostAmount:       current.CostAmount,
+		CostCurrency:     current.CostCurrency,
+		Version:          current.Version,
+
+		RecurrenceFrequency: current.RecurrenceFrequency,
+		RecurrenceInterval:  current.RecurrenceInterval,
+		RecurrenceWeekdays:  current.RecurrenceWeekdays,
+		RecurrenceUntil:     current.RecurrenceUntil,
+	}
+	if body.Title != nil {
+		update.Title = *body.Title
+	}
+	if body.OccursAt != nil {
+		update.OccursAt = pgtype.Timestamptz{Time: *body.OccursAt, Valid: true}
+
+		// Moving an activity keeps its duration unless a new end is sent.
+		if current.EndsAt.Valid {
+			update.EndsAt.Time = body.OccursAt.Add(current.EndsAt.Time.Sub(current.OccursAt.Time))
+		}
+	}
+	if body.EndsAt != nil || body.DurationMinutes != nil {
+		endsAt, ferr := activityEndsAt(r, update.OccursAt.Time, body.EndsAt, body.DurationMinutes)
+		if ferr != nil {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(*ferr)
+		}
+		update.EndsAt = endsAt
+	}
+	if body.TimeZone != nil {
+		update.TimeZone = toText(body.TimeZone)
+	}
+	if body.Location != nil {
+		update.Location = toText(body.Location)
+	}
+	if body.Category != nil {
+		update.Category = toCategory(body.Category)
+	}
+	if body.Address != nil {
+		update.Address = toText(body.Address)
+	}
+	if (body.Latitude == nil) != (body.Longitude == nil) {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(fieldError(r,
+			"longitude", "required_with", "must be sent with latitude",
+		))
+	}
+	if body.Latitude != nil {
+		update.Latitude = toFloat8(body.Latitude)
+		update.Longitude = toFloat8(body.Longitude)
+	}
+	if body.BookingReference != nil {
+		update.BookingReference = toText(body.BookingReference)
+	}
+	if body.EstimatedCost != nil {
+		update.CostAmount, update.CostCurrency = toCost(body.EstimatedCost)
+	}
+	if body.Recurrence != nil {
+		loc := pgstore.Activity{TimeZone: update.TimeZone}.Zone(trip)
+		recurrence, ferr := toRecurrence(r, *body.Recurrence, update.OccursAt.Time, loc)
+		if ferr != nil {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(*ferr)
+		}
+		update.RecurrenceFrequency = recurrence.frequency
+		update.RecurrenceInterval = recurrence.interval
+		update.RecurrenceWeekdays = recurrence.weekdays
+		update.RecurrenceUntil = recurrence.until
+	}
+
+	edited := pgstore.Activity{
+		ID:                  current.ID,
+		TripID:              current.TripID,
+		Title:               update.Title,
+		OccursAt:            update.OccursAt,
+		EndsAt:              update.EndsAt,
+		TimeZone:            update.TimeZone,
+		RecurrenceFrequency: update.RecurrenceFrequency,
+		RecurrenceInterval:  update.RecurrenceInterval,
+		RecurrenceWeekdays:  update.RecurrenceWeekdays,
+		RecurrenceUntil:     update.RecurrenceUntil,
+	}
+
+	// Moving a recurring activity or changing its rule moves every
+	// occurrence, so the whole series is checked again.
+	if body.OccursAt != nil || body.TimeZone != nil || body.Recurrence != nil {
+		if ferr := checkSeries(r, trip, edited); ferr != nil {
+			return spec.PatchTripsTripIDActivitiesActivityIDJSON400Response(*ferr)
+		}
+	}
+
+	warnings, err := ap.overlapWarnings(r, trip, edited)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find overlapping activities",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	if params.Strict != nil && *params.Strict && len(warnings) > 0 {
+		return errs.conflict(overlapError(r, warnings))
+	}
+
+	// The update only matches the version read above, so an edit that
+	// commits in between makes it return no rows instead of being lost.
+	updated, err := ap.store.UpdateTripActivity(r.Context(), update)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PatchTripsTripIDActivitiesActivityIDJSON412Response(
+			newError(r, spec.ErrorCodePreconditionFailed, "activity was changed since it was read"),
+		)
+	}
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to update trip activity",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDJSON200Response(
+		spec.UpdateActivityResponse{
+			Activity: activityResponse(trip, updated),
+			Warnings: warnings,
+		},
+	).Header("ETag", etag(updated.Version))
+}
+
+// Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (ap *API) DeleteTripsTripIDActivitiesActivityID(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	activityID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response,
+		internal: spec.DeleteTripsTripIDActivitiesActivityIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	if !canActOnTrip(r, id) {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	deleted, err := ap.store.DeleteTripActivity(r.Context(), pgstore.DeleteTripActivityParams{
+		ID:     activityUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to delete trip activity",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	if deleted == 0 {
+		return errs.notFound(newError(r, spec.ErrorCodeNotFound, "activity not found"))
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Modify a single occurrence of a recurring activity.
+// (PUT /trips/{tripId}/activities/{activityId}/occurrences/{date})
+func (ap *API) PutTripsTripIDActivitiesActivityIDOccurrencesDate(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	activityID string,
+	date openapi_types.Date,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON404Response,
+		conflict: spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON409Response,
+		internal: spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not edit activities"),
+		)
+	}
+
+	var body spec.UpdateOccurrenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(validationError(r, err))
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	act, err := ap.store.GetTripActivity(r.Context(), pgstore.GetTripActivityParams{
+		ID:     activityUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to get trip activity",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	if !act.RecurrenceFrequency.Valid {
+		return errs.conflict(newError(r, spec.ErrorCodeConflict, "activity does not repeat"))
+	}
+
+	o, ok := occurrenceOn(act, act.Zone(trip), dateIn(date, act.Zone(trip)))
+	if !ok {
+		return errs.notFound(newError(r, spec.ErrorCodeNotFound, "occurrence not found"))
+	}
+
+	exception := pgstore.UpsertActivityExceptionParams{
+		ActivityID:     act.ID,
+		OccurrenceDate: pgtype.Date{Time: date.Time, Valid: true},
+		Title:          toText(body.Title),
+		Location:       toText(body.Location),
+	}
+	if body.OccursAt != nil {
+		exception.OccursAt = pgtype.Timestamptz{Time: *body.OccursAt, Valid: true}
+	}
+	if body.EndsAt != nil {
+		exception.EndsAt = pgtype.Timestamptz{Time: *body.EndsAt, Valid: true}
+	}
+
+	o = applyException(o, pgstore.ActivityException{
+		Title:    exception.Title,
+		OccursAt: exception.OccursAt,
+		EndsAt:   exception.EndsAt,
+		Location: exception.Location,
+	})
+	if o.EndsAt.Valid && !o.EndsAt.Time.After(o.OccursAt.Time) {
+		return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(fieldError(r,
+			"ends_at", "gtfield", "must be after occurs_at",
+		))
+	}
+
+	if err := ap.store.UpsertActivityException(r.Context(), exception); err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to modify activity occurrence",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+			zap.String("date", date.String()),
+		)
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON204Response(nil)
+}
+
+// Cancel a single occurrence of a recurring activity.
+// (DELETE /trips/{tripId}/activities/{activityId}/occurrences/{date})
+func (ap *API) DeleteTripsTripIDActivitiesActivityIDOccurrencesDate(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	activityID string,
+	date openapi_types.Date,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON404Response,
+		conflict: spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON409Response,
+		internal: spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not edit activities"),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	act, err := ap.store.GetTripActivity(r.Context(), pgstore.GetTripActivityParams{
+		ID:     activityUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to get trip activity",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+		)
+	}
+
+	if !act.RecurrenceFrequency.Valid {
+		return errs.conflict(newError(r, spec.ErrorCodeConflict, "activity does not repeat"))
+	}
+
+	if !recursOn(act, act.Zone(trip), dateIn(date, act.Zone(trip))) {
+		return errs.notFound(newError(r, spec.ErrorCodeNotFound, "occurrence not found"))
+	}
+
+	err = ap.store.UpsertActivityException(r.Context(), pgstore.UpsertActivityExceptionParams{
+		ActivityID:     act.ID,
+		OccurrenceDate: pgtype.Date{Time: date.Time, Valid: true},
+		IsCancelled:    true,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "activity not found",
+			"failed to cancel activity occurrence",
+			zap.String("trip_id", tripID),
+			zap.String("activity_id", activityID),
+			zap.String("date", date.String()),
+		)
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON204Response(nil)
+}
+
+// Export a trip itinerary as iCalendar.
+// (GET /trips/{tripId}/calendar.ics)
+func (ap *API) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDCalendarIcsJSON404Response,
+		internal: spec.GetTripsTripIDCalendarIcsJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDCalendarIcsJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, memberRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.GetTripsTripIDCalendarIcsJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	activities, err := ap.store.GetTripActivities(r.Context(), pgstore.GetTripActivitiesParams{TripID: id})
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activities",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	exceptions, err := ap.store.GetTripActivityExceptions(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip activity exceptions",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	// The spec can only render JSON bodies, so the calendar is written here.
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+tripID+`.ics"`)
+	if err := tripCalendar(trip, activities, exceptions, time.Now()).Encode(w); err != nil {
+		ap.logger.Error("failed to write trip calendar", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	return nil
+}
+
+// Get the calendar subscription URL of a trip.
+// (GET /trips/{tripId}/calendar/subscription)
+func (ap *API) GetTripsTripIDCalendarSubscription(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDCalendarSubscriptionJSON404Response,
+		internal: spec.GetTripsTripIDCalendarSubscriptionJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDCalendarSubscriptionJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, memberRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.GetTripsTripIDCalendarSubscriptionJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	if _, err := ap.store.GetTrip(r.Context(), id); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	// The calendar token acts for the caller, so the subscription stops
+	// working once they lose access to the trip.
+	claims, _ := auth.FromContext(r.Context())
+	expiresAt := time.Now().Add(auth.CalendarTTL)
+	token, err := ap.signer.Issue(auth.Claims{
+		TripID:        id,
+		ParticipantID: claims.ParticipantID,
+		Role:          auth.RoleCalendar,
+		ExpiresAt:     expiresAt,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to issue calendar token",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	u, err := url.JoinPath(ap.baseURL, "trips", tripID, "calendar.ics")
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to build calendar url",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	return spec.GetTripsTripIDCalendarSubscriptionJSON200Response(spec.CalendarSubscription{
+		URL:       u + "?token=" + url.QueryEscape(token),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// Confirm a trip and send e-mail invitations.
+// (GET /trips/{tripId}/confirm)
+func (ap *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	if !wantsHTML(r) {
+		return ap.confirmTrip(r, tripID)
+	}
+
+	// Browsers only confirm once the owner presses the button of the page,
+	// so following the link doesn't.
+	locale := pageLocale(r, ap.tripPageLocale(r, tripID))
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		ap.renderLanding(w, locale, tripConfirmPages, spec.GetTripsTripIDConfirmJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		), "")
+		return nil
+	}
+	if !canActOnTrip(r, id) {
+		ap.renderLanding(w, locale, tripConfirmPages, spec.GetTripsTripIDConfirmJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		), "")
+		return nil
+	}
+
+	ap.renderPrompt(w, r, locale, tripConfirmPrompts, "")
+	return nil
+}
+
+// Confirm a trip from the page of its confirmation link.
+// (POST /trips/{tripId}/confirm)
+func (ap *API) PostTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	resp := ap.confirmTrip(r, tripID)
+	if wantsHTML(r) {
+		ap.renderLanding(w, pageLocale(r, ap.tripPageLocale(r, tripID)), tripConfirmPages, resp, "")
+		return nil
+	}
+	return resp
+}
+
+// confirmTrip confirms the trip with tripID and queues its invitations.
+func (ap *API) confirmTrip(r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDConfirmJSON404Response,
+		conflict: spec.GetTripsTripIDConfirmJSON409Response,
+		internal: spec.GetTripsTripIDConfirmJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDConfirmJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	if !canActOnTrip(r, id) {
+		return spec.GetTripsTripIDConfirmJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	if _, err := ap.store.GetTrip(r.Context(), id); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	// ConfirmTrip only flips trips that are still unconfirmed, so when the
+	// link is opened twice concurrently exactly one request sees a row
+	// affected and queues the invitations.
+	var confirmed int64
+	if err := ap.store.WithEmails(r.Context(), ap.pool, func(qtx *pgstore.Queries) ([]pgstore.EnqueueEmailParams, error) {
+		var err error
+		if confirmed, err = qtx.ConfirmTrip(r.Context(), id); err != nil || confirmed == 0 {
+			return nil, err
+		}
+		return []pgstore.EnqueueEmailParams{pgstore.TripEmail(pgstore.EmailKindTripConfirmed, id)}, nil
+	}); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to confirm trip",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	if confirmed == 0 {
+		return errs.conflict(newError(r, spec.ErrorCodeConflict, "trip already confirmed"))
+	}
+
+	return spec.GetTripsTripIDConfirmJSON204Response(nil)
+}
+
+// Invite someone to the trip.
+// (POST /trips/{tripId}/invites)
+func (ap *API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PostTripsTripIDInvitesJSON404Response,
+		internal: spec.PostTripsTripIDInvitesJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, organizerRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PostTripsTripIDInvitesJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "only organizers can invite people"),
+		)
+	}
+
+	var body spec.PostTripsTripIDInvitesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(validationError(r, err))
+	}
+
+	if err := ap.store.WithEmails(r.Context(), ap.pool, func(qtx *pgstore.Queries) ([]pgstore.EnqueueEmailParams, error) {
+		participantID, err := qtx.InviteParticipantToTrip(r.Context(), pgstore.InviteParticipantToTripParams{
+			TripID: id,
+			Email:  string(body.Email),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []pgstore.EnqueueEmailParams{
+			pgstore.ParticipantEmail(pgstore.EmailKindParticipantInvited, id, participantID),
+		}, nil
+	}); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to invite participant to trip",
+			zap.String("trip_id", tripID),
+			zap.String("participant_email", string(body.Email)),
+		)
+	}
+
+	return spec.PostTripsTripIDInvitesJSON201Response(nil)
+
+}
+
+// Get a trip links.
+// (GET /trips/{tripId}/links)
+func (ap *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDLinksJSON404Response,
+		internal: spec.GetTripsTripIDLinksJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	if _, err := ap.store.GetTrip(r.Context(), id); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	links, err := ap.store.GetTripLinks(r.Context(), id)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to find trip links",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	var output spec.GetLinksResponse
+
+	for _, link := range links {
+		output.Links = append(output.Links, linkResponse(link))
+	}
+
+	return spec.GetTripsTripIDLinksJSON200Response(output)
+}
+
+// Create a trip link.
+// (POST /trips/{tripId}/links)
+func (ap *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PostTripsTripIDLinksJSON404Response,
+		conflict: spec.PostTripsTripIDLinksJSON409Response,
+		internal: spec.PostTripsTripIDLinksJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.PostTripsTripIDLinksJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not add links"),
+		)
+	}
+
+	var body spec.PostTripsTripIDLinksJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(validationError(r, err))
+	}
+
+	linkID, err := ap.store.CreateTripLink(r.Context(),
+		pgstore.CreateTripLinkParams{
+			TripID: id,
+			Title:  body.Title,
+			Url:    body.URL,
+		})
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to create trip link",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	return spec.PostTripsTripIDLinksJSON201Response(
+		spec.CreateLinkResponse{LinkID: linkID.String()},
+	)
+}
+
+// Get a trip link.
+// (GET /trips/{tripId}/links/{linkId})
+func (ap *API) GetTripsTripIDLinksLinkID(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	linkID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDLinksLinkIDJSON404Response,
+		internal: spec.GetTripsTripIDLinksLinkIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	linkUUID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.GetTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	link, err := ap.store.GetTripLink(r.Context(), pgstore.GetTripLinkParams{
+		ID:     linkUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "link not found",
+			"failed to get trip link",
+			zap.String("trip_id", tripID),
+			zap.String("link_id", linkID),
+		)
+	}
+
+	return spec.GetTripsTripIDLinksLinkIDJSON200Response(linkResponse(link)).
+		Header("ETag", etag(link.Version))
+}
+
+// Update a trip link.
+// (PATCH /trips/{tripId}/links/{linkId})
+func (ap *API) PatchTripsTripIDLinksLinkID(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	linkID string,
+	params spec.PatchTripsTripIDLinksLinkIDParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.PatchTripsTripIDLinksLinkIDJSON404Response,
+		internal: spec.PatchTripsTripIDLinksLinkIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDLinksJSON400Response(
-				spec.Error{Message: "trip not found"},
-			)
-		}
-		ap.logger.Error(
-			"failed to find trip participants",
-			zap.Error(err),
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	linkUUID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	allowed, err := ap.authorize(r, id, contributorRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
 			zap.String("trip_id", tripID),
 		)
-		return spec.PostTripsTripIDActivitiesJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
+	}
+	if !allowed {
+		return spec.PatchTripsTripIDLinksLinkIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "viewers can not edit links"),
 		)
 	}
 
-	return spec.PostTripsTripIDLinksJSON201Response(
-		spec.CreateLinkResponse{LinkID: linkID.String()},
-	)
+	if params.IfMatch == nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON428Response(
+			newError(r, spec.ErrorCodePreconditionRequired, "If-Match header is required"),
+		)
+	}
+
+	var body spec.UpdateLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid JSON: "+err.Error()),
+		)
+	}
+
+	if err := ap.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDLinksLinkIDJSON400Response(validationError(r, err))
+	}
+
+	current, err := ap.store.GetTripLink(r.Context(), pgstore.GetTripLinkParams{
+		ID:     linkUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "link not found",
+			"failed to get trip link",
+			zap.String("trip_id", tripID),
+			zap.String("link_id", linkID),
+		)
+	}
+
+	if !matchesETag(*params.IfMatch, current.Version) {
+		return spec.PatchTripsTripIDLinksLinkIDJSON412Response(
+			newError(r, spec.ErrorCodePreconditionFailed, "link was changed since it was read"),
+		).Header("ETag", etag(current.Version))
+	}
+
+	update := pgstore.UpdateTripLinkParams{
+		ID:      current.ID,
+		TripID:  current.TripID,
+		Title:   current.Title,
+		Url:     current.Url,
+		Version: current.Version,
+	}
+	if body.Title != nil {
+		update.Title = *body.Title
+	}
+	if body.URL != nil {
+		update.Url = *body.URL
+	}
+
+	// The update only matches the version read above, so an edit that
+	// commits in between makes it return no rows instead of being lost.
+	updated, err := ap.store.UpdateTripLink(r.Context(), update)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PatchTripsTripIDLinksLinkIDJSON412Response(
+			newError(r, spec.ErrorCodePreconditionFailed, "link was changed since it was read"),
+		)
+	}
+	if err != nil {
+		return ap.storeError(r, errs, err, "link not found",
+			"failed to update trip link",
+			zap.String("trip_id", tripID),
+			zap.String("link_id", linkID),
+		)
+	}
+
+	return spec.PatchTripsTripIDLinksLinkIDJSON200Response(linkResponse(updated)).
+		Header("ETag", etag(updated.Version))
+}
+
+// Delete a trip link.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (ap *API) DeleteTripsTripIDLinksLinkID(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	linkID string,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.DeleteTripsTripIDLinksLinkIDJSON404Response,
+		internal: spec.DeleteTripsTripIDLinksLinkIDJSON500Response,
+	}
+
+	id, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	linkUUID, err := uuid.Parse(linkID)
+	if err != nil {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
+		)
+	}
+
+	if !canActOnTrip(r, id) {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	deleted, err := ap.store.DeleteTripLink(r.Context(), pgstore.DeleteTripLinkParams{
+		ID:     linkUUID,
+		TripID: id,
+	})
+	if err != nil {
+		return ap.storeError(r, errs, err, "link not found",
+			"failed to delete trip link",
+			zap.String("trip_id", tripID),
+			zap.String("link_id", linkID),
+		)
+	}
+
+	if deleted == 0 {
+		return errs.notFound(newError(r, spec.ErrorCodeNotFound, "link not found"))
+	}
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(nil)
 }
 
 // Get a trip participants.
 // (GET /trips/{tripId}/participants)
-func (ap *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+func (ap *API) GetTripsTripIDParticipants(
+	w http.ResponseWriter,
+	r *http.Request,
+	tripID string,
+	params spec.GetTripsTripIDParticipantsParams,
+) *spec.Response {
+	errs := errorResponses{
+		notFound: spec.GetTripsTripIDParticipantsJSON404Response,
+		internal: spec.GetTripsTripIDParticipantsJSON500Response,
+	}
+
 	id, err := uuid.Parse(tripID)
 	if err != nil {
 		return spec.GetTripsTripIDParticipantsJSON400Response(
-			spec.Error{Message: "invalid uuid passed: " + err.Error()},
+			newError(r, spec.ErrorCodeInvalidRequest, "invalid uuid passed: "+err.Error()),
 		)
 	}
 
-	participants, err := ap.store.GetParticipants(r.Context(), id)
+	allowed, err := ap.authorize(r, id, memberRoles)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDParticipantsJSON400Response(
-				spec.Error{Message: "trip not found"},
-			)
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	if !allowed {
+		return spec.GetTripsTripIDParticipantsJSON403Response(
+			newError(r, spec.ErrorCodeForbidden, "access token not issued for this trip"),
+		)
+	}
+
+	// Profiles are only shared with organizers, and with the participant
+	// they belong to.
+	organizer, err := ap.authorize(r, id, organizerRoles)
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to authorize participant",
+			zap.String("trip_id", tripID),
+		)
+	}
+	claims, _ := auth.FromContext(r.Context())
+
+	if _, err := ap.store.GetTrip(r.Context(), id); err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
+			"failed to get trip by id",
+			zap.String("trip_id", tripID),
+		)
+	}
+
+	for _, status := range params.Rsvp {
+		var rsvp spec.RSVPStatus
+		if err := rsvp.FromValue(status); err != nil {
+			return spec.GetTripsTripIDParticipantsJSON400Response(fieldError(r,
+				"rsvp", "oneof", "must be one of invited, accepted, declined, maybe or removed",
+			))
 		}
-		ap.logger.Error(
+	}
+
+	var participants []pgstore.Participant
+	if len(params.Rsvp) > 0 {
+		participants, err = ap.store.GetParticipantsByRSVP(r.Context(), pgstore.GetParticipantsByRSVPParams{
+			TripID:   id,
+			Statuses: params.Rsvp,
+		})
+	} else {
+		participants, err = ap.store.GetParticipants(r.Context(), id)
+	}
+	if err != nil {
+		return ap.storeError(r, errs, err, "trip not found",
 			"failed to find trip participants",
-			zap.Error(err),
 			zap.String("trip_id", tripID),
 		)
-		return spec.GetTripsTripIDParticipantsJSON400Response(
-			spec.Error{Message: "something went wrong, try again"},
-		)
 	}
 
 	var output spec.GetTripParticipantsResponse
@@ -490,19 +2373,486 @@ func (ap *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request
 	output.Participants = make([]spec.GetTripParticipantsResponseArray, len(participants))
 
 	for i, p := range participants {
-		var name string
-		parsedEmail, err := mail.ParseAddress(p.Email)
-		if err == nil {
-			addr := parsedEmail.Address
-			name = addr[:strings.Index(addr, "@")]
+		output.Participants[i] = participantResponse(p,
+			organizer || (claims.Role == auth.RoleParticipant && claims.ParticipantID == p.ID),
+		)
+	}
+
+	return spec.GetTripsTripIDParticipantsJSON200Response(output)
+}
+
+// participantResponse converts p to its response, with its profile fields
+// only when withProfile is set.
+func participantResponse(p pgstore.Participant, withProfile bool) spec.GetTripParticipantsResponseArray {
+	out := spec.GetTripParticipantsResponseArray{
+		Email:         openapi_types.Email(p.Email),
+		ID:            p.ID.String(),
+		IsConfirmed:   p.IsConfirmed,
+		Name:          fromText(p.Name),
+		Role:          participantRole(p.Role),
+		RsvpStatus:    rsvpStatus(p.RsvpStatus),
+		RsvpUpdatedAt: p.RsvpUpdatedAt.Time,
+	}
+
+	if withProfile {
+		out.Phone = fromText(p.Phone)
+		out.DietaryNotes = fromText(p.DietaryNotes)
+		out.AccessibilityNotes = fromText(p.AccessibilityNotes)
+		out.RsvpNote = fromText(p.RsvpNote)
+		out.Locale = fromLocale(p.Locale)
+	}
+
+	return out
+}
+
+// groupActivitiesByDay lists every calendar day between the trip starts_at
+// and ends_at, in the trip time zone, with the activities that happen on
+// it. Days before from or starting at until or later are left out when
+// those are set. Recurring activities are expanded into their occurrences
+// within the trip dates, with the changes in exceptions applied. Activities
+// outside the trip dates, left behind when they were created or the trip
+// changed, are returned apart instead of creating days the trip doesn't
+// have.
+func groupActivitiesByDay(
+	trip pgstore.Trip,
+	activities []pgstore.Activity,
+	exceptions []pgstore.ActivityException,
+	from, until pgtype.Timestamptz,
+) spec.GetTripActivitiesResponse {
+	output := spec.GetTripActivitiesResponse{
+		Activities:   []spec.GetTripActivitiesResponseOuterArray{},
+		OutsideRange: []spec.GetTripActivitiesResponseInnerArray{},
+	}
+
+	loc := trip.Zone()
+	startsAt := trip.StartsAt.Time.In(loc)
+	endsAt := trip.EndsAt.Time.In(loc)
+
+	days := make(map[string]int)
+	for day := startOfDay(startsAt, loc); !day.After(endsAt); day = day.AddDate(0, 0, 1) {
+		if (from.Valid && day.Before(from.Time)) || (until.Valid && !day.Before(until.Time)) {
+			continue
+		}
+
+		days[day.Format(time.DateOnly)] = len(output.Activities)
+		output.Activities = append(output.Activities, spec.GetTripActivitiesResponseOuterArray{
+			Date:       day,
+			Activities: []spec.GetTripActivitiesResponseInnerArray{},
+		})
+	}
+
+	place := func(occursAt time.Time, act spec.GetTripActivitiesResponseInnerArray) {
+		if occursAt.Before(startsAt) || occursAt.After(endsAt) {
+			output.OutsideRange = append(output.OutsideRange, act)
+			return
 		}
-		output.Participants[i] = spec.GetTripParticipantsResponseArray{
-			Email:       openapi_types.Email(p.Email),
-			ID:          p.ID.String(),
-			IsConfirmed: p.IsConfirmed,
-			Name:        &name,
+
+		i, ok := days[occursAt.In(loc).Format(time.DateOnly)]
+		if !ok {
+			return
 		}
+		output.Activities[i].Activities = append(output.Activities[i].Activities, act)
 	}
 
-	return spec.GetTripsTripIDParticipantsJSON200Response(output)
+	changed := exceptionsByActivity(exceptions)
+	for _, act := range activities {
+		if !act.RecurrenceFrequency.Valid {
+			place(act.OccursAt.Time, activityResponse(trip, act))
+			continue
+		}
+
+		for _, o := range expandRecurrence(act, act.Zone(trip), startsAt, endsAt, changed[act.ID]) {
+			res := activityResponse(trip, o.activity)
+			res.OccurrenceDate = &openapi_types.Date{Time: o.date}
+			place(o.activity.OccursAt.Time, res)
+		}
+	}
+
+	// Occurrences are added series by series, so days are sorted again.
+	for _, day := range output.Activities {
+		slices.SortStableFunc(day.Activities, func(a, b spec.GetTripActivitiesResponseInnerArray) int {
+			return a.OccursAt.Compare(b.OccursAt)
+		})
+	}
+
+	return output
+}
+
+// dateIn returns midnight of date in loc.
+func dateIn(date openapi_types.Date, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+// startOfDay returns midnight of the calendar day of t in loc.
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// linkResponse converts a stored link to its API value.
+func linkResponse(link pgstore.Link) spec.GetLinksResponseArray {
+	return spec.GetLinksResponseArray{
+		ID:      link.ID.String(),
+		Title:   link.Title,
+		URL:     link.Url,
+		Version: int(link.Version),
+	}
+}
+
+// activityResponse converts a stored activity of trip to its API value.
+func activityResponse(trip pgstore.Trip, act pgstore.Activity) spec.GetTripActivitiesResponseInnerArray {
+	loc := act.Zone(trip)
+	output := spec.GetTripActivitiesResponseInnerArray{
+		ID:            act.ID.String(),
+		Title:         act.Title,
+		OccursAt:      act.OccursAt.Time.UTC(),
+		OccursAtLocal: act.OccursAt.Time.In(loc),
+		TimeZone:      loc.String(),
+		Location:      fromText(act.Location),
+		Version:       int(act.Version),
+
+		Category:         fromCategory(act.Category),
+		Address:          fromText(act.Address),
+		Latitude:         fromFloat8(act.Latitude),
+		Longitude:        fromFloat8(act.Longitude),
+		BookingReference: fromText(act.BookingReference),
+		EstimatedCost:    fromCost(act.CostAmount, act.CostCurrency),
+		Recurrence:       fromRecurrence(act, loc),
+	}
+
+	if act.EndsAt.Valid {
+		endsAt := act.EndsAt.Time.UTC()
+		endsAtLocal := act.EndsAt.Time.In(loc)
+		duration := int(act.EndsAt.Time.Sub(act.OccursAt.Time).Minutes())
+		output.EndsAt = &endsAt
+		output.EndsAtLocal = &endsAtLocal
+		output.DurationMinutes = &duration
+	}
+
+	return output
+}
+
+// activityEndsAt resolves the end of an activity starting at occursAt from
+// either ends_at or duration_minutes. Activities without either have no end.
+func activityEndsAt(r *http.Request, occursAt time.Time, endsAt *time.Time, durationMinutes *int) (pgtype.Timestamptz, *spec.Error) {
+	switch {
+	case endsAt != nil && durationMinutes != nil:
+		e := fieldError(r, "duration_minutes", "excluded_with", "can't be sent with ends_at")
+		return pgtype.Timestamptz{}, &e
+	case durationMinutes != nil:
+		return pgtype.Timestamptz{Time: occursAt.Add(time.Duration(*durationMinutes) * time.Minute), Valid: true}, nil
+	case endsAt != nil:
+		if !endsAt.After(occursAt) {
+			e := fieldError(r, "ends_at", "gtfield", "must be after occurs_at")
+			return pgtype.Timestamptz{}, &e
+		}
+		return pgtype.Timestamptz{Time: *endsAt, Valid: true}, nil
+	}
+	return pgtype.Timestamptz{}, nil
+}
+
+// activityParams validates a new activity of trip and converts it to the
+// values it is created with.
+func (ap *API) activityParams(
+	r *http.Request,
+	trip pgstore.Trip,
+	body spec.CreateActivityRequest,
+) (pgstore.CreateActivityParams, *spec.Error) {
+	if err := ap.validator.Struct(body); err != nil {
+		e := validationError(r, err)
+		return pgstore.CreateActivityParams{}, &e
+	}
+
+	endsAt, ferr := activityEndsAt(r, body.OccursAt, body.EndsAt, body.DurationMinutes)
+	if ferr != nil {
+		return pgstore.CreateActivityParams{}, ferr
+	}
+
+	if (body.Latitude == nil) != (body.Longitude == nil) {
+		e := fieldError(r, "longitude", "required_with", "must be sent with latitude")
+		return pgstore.CreateActivityParams{}, &e
+	}
+
+	recurrence := noRecurrence()
+	if body.Recurrence != nil {
+		loc := pgstore.Activity{TimeZone: toText(body.TimeZone)}.Zone(trip)
+		if recurrence, ferr = toRecurrence(r, *body.Recurrence, body.OccursAt, loc); ferr != nil {
+			return pgstore.CreateActivityParams{}, ferr
+		}
+	}
+
+	costAmount, costCurrency := toCost(body.EstimatedCost)
+	return pgstore.CreateActivityParams{
+		TripID:           trip.ID,
+		Title:            body.Title,
+		OccursAt:         pgtype.Timestamptz{Time: body.OccursAt, Valid: true},
+		EndsAt:           endsAt,
+		TimeZone:         toText(body.TimeZone),
+		Location:         toText(body.Location),
+		Category:         toCategory(body.Category),
+		Address:          toText(body.Address),
+		Latitude:         toFloat8(body.Latitude),
+		Longitude:        toFloat8(body.Longitude),
+		BookingReference: toText(body.BookingReference),
+		CostAmount:       costAmount,
+		CostCurrency:     costCurrency,
+
+		RecurrenceFrequency: recurrence.frequency,
+		RecurrenceInterval:  recurrence.interval,
+		RecurrenceWeekdays:  recurrence.weekdays,
+		RecurrenceUntil:     recurrence.until,
+	}, nil
+}
+
+// createdActivity returns the activity params creates, as far as its time
+// and recurrence go.
+func createdActivity(params pgstore.CreateActivityParams) pgstore.Activity {
+	return pgstore.Activity{
+		TripID:              params.TripID,
+		Title:               params.Title,
+		OccursAt:            params.OccursAt,
+		EndsAt:              params.EndsAt,
+		TimeZone:            params.TimeZone,
+		RecurrenceFrequency: params.RecurrenceFrequency,
+		RecurrenceInterval:  params.RecurrenceInterval,
+		RecurrenceWeekdays:  params.RecurrenceWeekdays,
+		RecurrenceUntil:     params.RecurrenceUntil,
+	}
+}
+
+// overlapWarnings lists the activities of trip, other than act itself, that
+// happen at the same time as act. Recurring activities are compared
+// occurrence by occurrence within the trip dates, with the changes made to
+// single occurrences applied.
+func (ap *API) overlapWarnings(r *http.Request, trip pgstore.Trip, act pgstore.Activity) ([]spec.ActivityWarning, error) {
+	exceptions, err := ap.store.GetTripActivityExceptions(r.Context(), trip.ID)
+	if err != nil {
+		return nil, err
+	}
+	changed := exceptionsByActivity(exceptions)
+
+	spans := activitySpans(trip, act, changed[act.ID])
+	if len(spans) == 0 {
+		return []spec.ActivityWarning{}, nil
+	}
+
+	// The query only narrows the activities down to the ones that may
+	// overlap from the first to the last span.
+	from, until := spans[0].occursAt, spans[0].end()
+	for _, sp := range spans[1:] {
+		if sp.occursAt.Time.Before(from.Time) {
+			from = sp.occursAt
+		}
+		if end := sp.end(); end.Time.After(until.Time) {
+			until = end
+		}
+	}
+
+	candidates, err := ap.store.GetOverlappingActivities(r.Context(), pgstore.GetOverlappingActivitiesParams{
+		TripID:    trip.ID,
+		ExcludeID: act.ID,
+		OccursAt:  from,
+		EndsAt:    until,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := []spec.ActivityWarning{}
+	for _, other := range candidates {
+		if spansOverlap(spans, activitySpans(trip, other, changed[other.ID])) {
+			warnings = append(warnings, overlapWarning(other.ID, other.Title))
+		}
+	}
+
+	return warnings, nil
+}
+
+// span is the time an activity, or one of its occurrences, takes.
+type span struct {
+	occursAt pgtype.Timestamptz
+	endsAt   pgtype.Timestamptz
+}
+
+// end returns when sp ends, which is when it starts if it has no end.
+func (sp span) end() pgtype.Timestamptz {
+	if !sp.endsAt.Valid {
+		return sp.occursAt
+	}
+	return sp.endsAt
+}
+
+// activitySpans lists the times act takes: its own when it doesn't repeat,
+// or the ones of its occurrences within the dates of trip, expanded like
+// the itinerary lists them.
+func activitySpans(trip pgstore.Trip, act pgstore.Activity, exceptions map[string]pgstore.ActivityException) []span {
+	if !act.RecurrenceFrequency.Valid {
+		return []span{{act.OccursAt, act.EndsAt}}
+	}
+
+	loc := act.Zone(trip)
+	occurrences := expandRecurrence(act, loc, trip.StartsAt.Time.In(loc), trip.EndsAt.Time.In(loc), exceptions)
+	spans := make([]span, len(occurrences))
+	for i, o := range occurrences {
+		spans[i] = span{o.activity.OccursAt, o.activity.EndsAt}
+	}
+	return spans
+}
+
+// spansOverlap reports whether any of spans overlaps any of others.
+func spansOverlap(spans, others []span) bool {
+	for _, sp := range spans {
+		for _, other := range others {
+			if overlaps(sp.occursAt, sp.endsAt, other.occursAt, other.endsAt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlapWarning warns that an activity overlaps the activity id titled title.
+func overlapWarning(id uuid.UUID, title string) spec.ActivityWarning {
+	return spec.ActivityWarning{
+		Code:       spec.ActivityWarningCodeOverlap,
+		Message:    fmt.Sprintf("overlaps with %q", title),
+		ActivityID: id.String(),
+	}
+}
+
+// overlaps reports whether two spans of time overlap. Activities without an
+// end only take the moment they start, and overlap the ones starting then.
+func overlaps(occursAt, endsAt, otherOccursAt, otherEndsAt pgtype.Timestamptz) bool {
+	end, otherEnd := endsAt.Time, otherEndsAt.Time
+	if !endsAt.Valid {
+		end = occursAt.Time
+	}
+	if !otherEndsAt.Valid {
+		otherEnd = otherOccursAt.Time
+	}
+	return occursAt.Time.Equal(otherOccursAt.Time) ||
+		(occursAt.Time.Before(otherEnd) && otherOccursAt.Time.Before(end))
+}
+
+// overlapError reports the warnings of an activity rejected in strict mode.
+func overlapError(r *http.Request, warnings []spec.ActivityWarning) spec.Error {
+	messages := make([]string, len(warnings))
+	for i, w := range warnings {
+		messages[i] = w.Message
+	}
+	return newError(r, spec.ErrorCodeConflict, "activity "+strings.Join(messages, ", "))
+}
+
+// participantRole converts a stored role to its API value. Both enums list
+// the same values, so the conversion can't fail.
+func participantRole(role pgstore.ParticipantRole) spec.ParticipantRole {
+	var out spec.ParticipantRole
+	_ = out.FromValue(string(role))
+	return out
+}
+
+// rsvpStatus converts a stored RSVP state to its API value.
+func rsvpStatus(status pgstore.RsvpStatus) spec.RSVPStatus {
+	var out spec.RSVPStatus
+	_ = out.FromValue(string(status))
+	return out
+}
+
+// locale converts a stored locale to its API value. Both enums list the
+// same values, so the conversion can't fail.
+func locale(l pgstore.Locale) spec.Locale {
+	var out spec.Locale
+	_ = out.FromValue(string(l))
+	return out
+}
+
+// toLocale converts an optional request field to a nullable column.
+func toLocale(l *spec.Locale) pgstore.NullLocale {
+	if l == nil {
+		return pgstore.NullLocale{}
+	}
+	return pgstore.NullLocale{Locale: pgstore.Locale(l.ToValue()), Valid: true}
+}
+
+// fromLocale converts a nullable column to an optional response field.
+func fromLocale(l pgstore.NullLocale) *string {
+	if !l.Valid {
+		return nil
+	}
+	s := string(l.Locale)
+	return &s
+}
+
+// toText converts an optional request field to a nullable column.
+func toText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+// fromText converts a nullable column to an optional response field.
+func fromText(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+// toCategory converts an optional activity category to a nullable column.
+func toCategory(c *spec.ActivityCategory) pgstore.NullActivityCategory {
+	if c == nil {
+		return pgstore.NullActivityCategory{}
+	}
+	return pgstore.NullActivityCategory{ActivityCategory: pgstore.ActivityCategory(c.ToValue()), Valid: true}
+}
+
+// fromCategory converts a nullable category column to an optional response
+// field. Both enums list the same values, so the conversion can't fail.
+func fromCategory(c pgstore.NullActivityCategory) *spec.ActivityCategory {
+	if !c.Valid {
+		return nil
+	}
+	var out spec.ActivityCategory
+	_ = out.FromValue(string(c.ActivityCategory))
+	return &out
+}
+
+// toFloat8 converts an optional request field to a nullable column.
+func toFloat8(f *float64) pgtype.Float8 {
+	if f == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *f, Valid: true}
+}
+
+// fromFloat8 converts a nullable column to an optional response field.
+func fromFloat8(f pgtype.Float8) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// toCost converts an optional cost to its amount and currency columns. The
+// amount is stored as NUMERIC, rounded to cents.
+func toCost(c *spec.ActivityCost) (pgtype.Numeric, pgtype.Text) {
+	var amount pgtype.Numeric
+	if c == nil {
+		return amount, pgtype.Text{}
+	}
+	_ = amount.Scan(strconv.FormatFloat(c.Amount, 'f', 2, 64))
+	return amount, pgtype.Text{String: c.Currency, Valid: true}
+}
+
+// fromCost converts the cost columns to an optional response field.
+func fromCost(amount pgtype.Numeric, currency pgtype.Text) *spec.ActivityCost {
+	if !amount.Valid || !currency.Valid {
+		return nil
+	}
+	f, err := amount.Float64Value()
+	if err != nil {
+		return nil
+	}
+	return &spec.ActivityCost{Amount: f.Float64, Currency: currency.String}
 }
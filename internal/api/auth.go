@@ -0,0 +1,148 @@
+package api
+
+import (
+	"crypto/subtle"
+	"errors"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+	"github.com/EyzRyder/Travel-Planner/internal/auth"
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// organizerRoles may change the trip itself and invite people to it.
+	organizerRoles = []pgstore.ParticipantRole{
+		pgstore.ParticipantRoleCoOrganizer,
+	}
+	// contributorRoles may add activities and links to the trip.
+	contributorRoles = []pgstore.ParticipantRole{
+		pgstore.ParticipantRoleCoOrganizer,
+		pgstore.ParticipantRoleParticipant,
+	}
+	// memberRoles may read what is only shared with people on the trip.
+	memberRoles = []pgstore.ParticipantRole{
+		pgstore.ParticipantRoleCoOrganizer,
+		pgstore.ParticipantRoleParticipant,
+		pgstore.ParticipantRoleViewer,
+	}
+)
+
+// Authenticate is the middleware for operations secured by the magicLink
+// scheme in the spec. It requires a valid token whose role is one of the
+// operation's scopes and stores its claims in the request context, leaving
+// handlers to check the token was issued for the trip they act on.
+func (ap *API) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := requestToken(r)
+		if token == "" {
+			writeError(w, r, http.StatusUnauthorized,
+				newError(r, spec.ErrorCodeUnauthorized, "missing access token"),
+			)
+			return
+		}
+
+		claims, err := ap.signer.Verify(token)
+		if err != nil {
+			writeError(w, r, http.StatusUnauthorized,
+				newError(r, spec.ErrorCodeUnauthorized, "invalid or expired access token"),
+			)
+			return
+		}
+
+		scopes, _ := r.Context().Value(spec.MagicLinkScopes).([]string)
+		if !slices.Contains(scopes, string(claims.Role)) {
+			writeError(w, r, http.StatusForbidden,
+				newError(r, spec.ErrorCodeForbidden, "access token not allowed for this operation"),
+			)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithClaims(r.Context(), claims)))
+	})
+}
+
+// AuthenticateInbound is the middleware for operations secured by the
+// inboundSecret scheme in the spec, called by the service forwarding the
+// e-mails the application receives. It requires the X-Inbound-Secret header
+// to hold secret. Those operations stay disabled while secret is empty.
+func AuthenticateInbound(secret string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got := r.Header.Get("X-Inbound-Secret")
+			if secret == "" || subtle.ConstantTimeCompare([]byte(got), []byte(secret)) != 1 {
+				writeError(w, r, http.StatusUnauthorized,
+					newError(r, spec.ErrorCodeUnauthorized, "missing or invalid inbound secret"),
+				)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requestToken reads the token from the Authorization header, falling back
+// to the token field posted by the landing pages and then to the token
+// query parameter used by the links in the e-mails.
+func requestToken(r *http.Request) string {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return strings.TrimSpace(token)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/x-www-form-urlencoded" {
+		if token := r.PostFormValue("token"); token != "" {
+			return token
+		}
+	}
+	return r.URL.Query().Get("token")
+}
+
+// canActOnTrip reports whether the token of r was issued for tripID.
+func canActOnTrip(r *http.Request, tripID uuid.UUID) bool {
+	claims, ok := auth.FromContext(r.Context())
+	return ok && claims.TripID == tripID
+}
+
+// canActAsParticipant reports whether the token of r was issued to
+// participantID.
+func canActAsParticipant(r *http.Request, participantID uuid.UUID) bool {
+	claims, ok := auth.FromContext(r.Context())
+	return ok && claims.Role == auth.RoleParticipant && claims.ParticipantID == participantID
+}
+
+// authorize reports whether the caller may act on tripID. The owner always
+// can, participants only when their current role is one of roles and they
+// weren't removed from the trip. Both are looked up on every request so a
+// change applies to tokens already sent.
+func (ap *API) authorize(r *http.Request, tripID uuid.UUID, roles []pgstore.ParticipantRole) (bool, error) {
+	claims, ok := auth.FromContext(r.Context())
+	if !ok || claims.TripID != tripID {
+		return false, nil
+	}
+
+	// Calendar tokens act for whoever they were issued to, and are only
+	// accepted by the operations reading the calendar.
+	if claims.Role == auth.RoleOwner || (claims.Role == auth.RoleCalendar && claims.ParticipantID == uuid.Nil) {
+		return true, nil
+	}
+
+	participant, err := ap.store.GetParticipant(r.Context(), claims.ParticipantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Participants removed from the trip keep a valid token but lose access
+	// with their role.
+	return participant.TripID == tripID &&
+		participant.RsvpStatus != pgstore.RsvpStatusRemoved &&
+		slices.Contains(roles, participant.Role), nil
+}
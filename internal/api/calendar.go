@@ -0,0 +1,295 @@
+package api
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+	"github.com/EyzRyder/Travel-Planner/internal/ical"
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxCalendarSize is the largest calendar accepted in a request body.
+const maxCalendarSize = 1 << 20
+
+// tripCalendar renders trip as a calendar with an all-day event spanning
+// its dates and one event per activity, stamped at stamp. Recurring
+// activities get one event per occurrence within the trip dates, including
+// the cancelled ones. UIDs are
+// derived from the trip and activity IDs, so importing the calendar again
+// updates the events instead of duplicating them.
+func tripCalendar(
+	trip pgstore.Trip,
+	activities []pgstore.Activity,
+	exceptions []pgstore.ActivityException,
+	stamp time.Time,
+) ical.Calendar {
+	loc := trip.Zone()
+	startsAt := trip.StartsAt.Time.In(loc)
+	endsAt := trip.EndsAt.Time.In(loc)
+
+	cal := ical.Calendar{
+		Name: trip.Destination,
+		Events: []ical.Event{{
+			UID:     trip.CalendarUID(),
+			Stamp:   stamp,
+			AllDay:  true,
+			Start:   startOfDay(startsAt, loc),
+			End:     startOfDay(endsAt, loc).AddDate(0, 0, 1),
+			Summary: trip.Destination,
+		}},
+	}
+
+	changed := exceptionsByActivity(exceptions)
+	for _, act := range activities {
+		if !act.RecurrenceFrequency.Valid {
+			cal.Events = append(cal.Events, activityEvent(act, act.CalendarUID(""), stamp))
+			continue
+		}
+
+		// Occurrences changed on their own count their changes on top of
+		// the activity version, and cancelled ones stay in the calendar so
+		// subscribed apps remove them.
+		for _, o := range expandRecurrence(act, act.Zone(trip), startsAt, endsAt, nil) {
+			e, ok := changed[act.ID][o.date.Format(time.DateOnly)]
+			if ok && !e.IsCancelled {
+				o.activity = applyException(o.activity, e)
+			}
+
+			ev := activityEvent(o.activity, act.CalendarUID(o.date.Format("20060102")), stamp)
+			if ok {
+				ev.Sequence += int(e.Version)
+			}
+			if ok && e.IsCancelled {
+				ev.Status = ical.StatusCancelled
+			}
+			cal.Events = append(cal.Events, ev)
+		}
+	}
+
+	return cal
+}
+
+// activityEvent converts an activity to the event with the given UID. The
+// sequence follows the activity version, so calendar apps replace the
+// events they have when the activity changes.
+func activityEvent(act pgstore.Activity, uid string, stamp time.Time) ical.Event {
+	ev := ical.Event{
+		UID:      uid,
+		Sequence: int(act.Version) - 1,
+		Stamp:    stamp,
+		Start:    act.OccursAt.Time,
+		Summary:  act.Title,
+	}
+	if act.EndsAt.Valid {
+		ev.End = act.EndsAt.Time
+	}
+
+	var location []string
+	if act.Location.Valid {
+		location = append(location, act.Location.String)
+	}
+	if act.Address.Valid {
+		location = append(location, act.Address.String)
+	}
+	ev.Location = strings.Join(location, ", ")
+
+	if act.Latitude.Valid && act.Longitude.Valid {
+		ev.Geo = &[2]float64{act.Latitude.Float64, act.Longitude.Float64}
+	}
+	if act.Category.Valid {
+		ev.Categories = []string{strings.ToUpper(string(act.Category.ActivityCategory))}
+	}
+
+	var description []string
+	if act.BookingReference.Valid {
+		description = append(description, "Booking reference: "+act.BookingReference.String)
+	}
+	if cost := fromCost(act.CostAmount, act.CostCurrency); cost != nil {
+		description = append(description, "Estimated cost: "+strconv.FormatFloat(cost.Amount, 'f', 2, 64)+" "+cost.Currency)
+	}
+	ev.Description = strings.Join(description, "\n")
+
+	return ev
+}
+
+// calendarBody returns the calendar sent in the body of r, either as is or
+// as the file field of a form.
+func calendarBody(w http.ResponseWriter, r *http.Request) (io.Reader, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxCalendarSize)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return r.Body, nil
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// importActivities turns the events of cal into activities of trip. Events
+// starting outside the trip dates, exported from the trip itself or listed
+// twice are skipped, and so are cancelled events, events without a start
+// or summary and the ones cal couldn't read. All-day events last from the
+// start of their first day to the end of their last in the trip time zone,
+// cut to the trip dates. Recurrence rules aren't read, so recurring events
+// only create their first occurrence.
+func importActivities(
+	trip pgstore.Trip,
+	existing []pgstore.Activity,
+	cal ical.Calendar,
+) ([]pgstore.CreateActivityParams, []spec.ImportedActivity, []spec.SkippedEvent) {
+	var (
+		params   []pgstore.CreateActivityParams
+		imported = []spec.ImportedActivity{}
+		skipped  = []spec.SkippedEvent{}
+	)
+
+	loc := trip.Zone()
+	startsAt := trip.StartsAt.Time
+	endsAt := trip.EndsAt.Time
+
+	inTrip := make(map[uuid.UUID]bool, len(existing))
+	for _, act := range existing {
+		inTrip[act.ID] = true
+	}
+	seen := make(map[string]bool)
+
+	for _, ev := range cal.Invalid {
+		skipped = append(skipped, spec.SkippedEvent{
+			UID:     ev.UID,
+			Title:   truncate(strings.TrimSpace(ev.Summary), 255),
+			Reason:  spec.SkippedEventReasonInvalid,
+			Message: ev.Err.Error(),
+		})
+	}
+
+	for _, ev := range cal.Events {
+		title := truncate(strings.TrimSpace(ev.Summary), 255)
+		skip := func(reason spec.SkippedEventReason, message string) {
+			skipped = append(skipped, spec.SkippedEvent{UID: ev.UID, Title: title, Reason: reason, Message: message})
+		}
+
+		activityID, fromActivity := pgstore.ActivityIDFromCalendarUID(ev.UID)
+		switch {
+		case ev.UID == trip.CalendarUID():
+			skip(spec.SkippedEventReasonDuplicate, "event spans the trip itself")
+			continue
+		case fromActivity && inTrip[activityID]:
+			skip(spec.SkippedEventReasonDuplicate, "activity is already in the trip")
+			continue
+		case ev.UID != "" && seen[ev.UID]:
+			skip(spec.SkippedEventReasonDuplicate, "event is listed more than once")
+			continue
+		case ev.Start.IsZero():
+			skip(spec.SkippedEventReasonInvalid, "event has no start")
+			continue
+		case title == "":
+			skip(spec.SkippedEventReasonInvalid, "event has no summary")
+			continue
+		case ev.Status == ical.StatusCancelled:
+			skip(spec.SkippedEventReasonInvalid, "event is cancelled")
+			continue
+		}
+		seen[ev.UID] = true
+
+		occursAt, until := ev.Start, ev.End
+		var timeZone *string
+		if ev.AllDay {
+			occursAt = time.Date(ev.Start.Year(), ev.Start.Month(), ev.Start.Day(), 0, 0, 0, 0, loc)
+			until = occursAt.AddDate(0, 0, 1)
+			if !ev.End.IsZero() {
+				until = time.Date(ev.End.Year(), ev.End.Month(), ev.End.Day(), 0, 0, 0, 0, loc)
+			}
+
+			// Trips rarely start or end at midnight, so all-day events on
+			// their first or last day are cut to fit.
+			if occursAt.Before(startsAt) && until.After(startsAt) {
+				occursAt = startsAt
+			}
+			if until.After(endsAt) && occursAt.Before(endsAt) {
+				until = endsAt
+			}
+		} else if name := ev.Start.Location().String(); name != "UTC" && name != trip.TimeZone {
+			// Time zones defined by the calendar itself aren't IANA names,
+			// so those times are kept in UTC.
+			if _, err := time.LoadLocation(name); err == nil {
+				timeZone = &name
+			}
+		}
+
+		if occursAt.Before(startsAt) || occursAt.After(endsAt) {
+			skip(spec.SkippedEventReasonOutsideRange, "event starts outside the trip dates")
+			continue
+		}
+
+		act := pgstore.CreateActivityParams{
+			TripID:   trip.ID,
+			Title:    title,
+			OccursAt: pgtype.Timestamptz{Time: occursAt, Valid: true},
+			TimeZone: toText(timeZone),
+
+			RecurrenceInterval: 1,
+			RecurrenceWeekdays: []int16{},
+		}
+		if until.After(occursAt) {
+			act.EndsAt = pgtype.Timestamptz{Time: until, Valid: true}
+		}
+		if location := truncate(strings.TrimSpace(ev.Location), 255); location != "" {
+			act.Location = pgtype.Text{String: location, Valid: true}
+		}
+		if ev.Geo != nil && ev.Geo[0] >= -90 && ev.Geo[0] <= 90 && ev.Geo[1] >= -180 && ev.Geo[1] <= 180 {
+			act.Latitude = pgtype.Float8{Float64: ev.Geo[0], Valid: true}
+			act.Longitude = pgtype.Float8{Float64: ev.Geo[1], Valid: true}
+		}
+		for _, c := range ev.Categories {
+			var category spec.ActivityCategory
+			if category.FromValue(strings.ToLower(c)) == nil {
+				act.Category = toCategory(&category)
+				break
+			}
+		}
+
+		params = append(params, act)
+		imported = append(imported, spec.ImportedActivity{
+			UID:      ev.UID,
+			Title:    act.Title,
+			OccursAt: occursAt.UTC(),
+			TimeZone: timeZone,
+			Location: fromText(act.Location),
+			Category: fromCategory(act.Category),
+			EndsAt:   fromTimestamptz(act.EndsAt),
+		})
+	}
+
+	return params, imported, skipped
+}
+
+// fromTimestamptz converts a nullable column to an optional response field
+// in UTC.
+func fromTimestamptz(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	utc := t.Time.UTC()
+	return &utc
+}
+
+// truncate shortens s to at most n runes.
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+// errorResponses holds the spec constructors an operation uses to report
+// failures. Operations that can't produce a given status leave it nil.
+type errorResponses struct {
+	notFound func(spec.Error) *spec.Response
+	conflict func(spec.Error) *spec.Response
+	internal func(spec.Error) *spec.Response
+}
+
+// newError builds an error body tagged with the ID the RequestID middleware
+// assigned to r, so support can match it with the server logs.
+func newError(r *http.Request, code spec.ErrorCode, message string) spec.Error {
+	return spec.Error{
+		Code:      code,
+		Message:   message,
+		RequestID: middleware.GetReqID(r.Context()),
+	}
+}
+
+// fieldError reports a single field that failed a check the validator
+// can't express.
+func fieldError(r *http.Request, field, rule, message string) spec.Error {
+	e := newError(r, spec.ErrorCodeValidationFailed, "invalid input: "+field+" "+message)
+	e.Fields = []spec.FieldError{{Field: field, Rule: rule, Message: message}}
+	return e
+}
+
+// validationError turns the errors returned by the validator into one field
+// error per failed rule, named after the JSON field of the request body.
+func validationError(r *http.Request, err error) spec.Error {
+	e := newError(r, spec.ErrorCodeValidationFailed, "invalid input")
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		e.Message = "invalid input: " + err.Error()
+		return e
+	}
+
+	e.Fields = make([]spec.FieldError, len(verrs))
+	for i, fe := range verrs {
+		// Namespace is prefixed by the struct name, e.g. CreateTripRequest.owner_email.
+		field := fe.Namespace()
+		if _, after, ok := strings.Cut(field, "."); ok {
+			field = after
+		}
+
+		message := fmt.Sprintf("failed on the '%s' rule", fe.Tag())
+		if fe.Param() != "" {
+			message = fmt.Sprintf("failed on the '%s=%s' rule", fe.Tag(), fe.Param())
+		}
+
+		e.Fields[i] = spec.FieldError{
+			Field:   field,
+			Rule:    fe.Tag(),
+			Message: message,
+		}
+	}
+
+	return e
+}
+
+// jsonTagName makes the validator report fields by their JSON name.
+func jsonTagName(fld reflect.StructField) string {
+	name, _, _ := strings.Cut(fld.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// storeError translates an error returned by the store into the operation's
+// error response. Missing rows and foreign key violations are reported as
+// not found with the given message, unique violations as a conflict, and
+// anything else is logged and reported as an internal error.
+func (ap *API) storeError(
+	r *http.Request,
+	res errorResponses,
+	err error,
+	notFound string,
+	logMsg string,
+	fields ...zap.Field,
+) *spec.Response {
+	if errors.Is(err, pgx.ErrNoRows) && res.notFound != nil {
+		return res.notFound(newError(r, spec.ErrorCodeNotFound, notFound))
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgerrcode.ForeignKeyViolation:
+			if res.notFound != nil {
+				return res.notFound(newError(r, spec.ErrorCodeNotFound, notFound))
+			}
+		case pgerrcode.UniqueViolation:
+			if res.conflict != nil {
+				return res.conflict(newError(r, spec.ErrorCodeConflict, "resource already exists"))
+			}
+		}
+	}
+
+	fields = append(fields, zap.Error(err), zap.String("req_id", middleware.GetReqID(r.Context())))
+	ap.logger.Error(logMsg, fields...)
+	return res.internal(newError(r, spec.ErrorCodeInternal, "something went wrong, try again"))
+}
+
+// HandleParamError reports path and query parameters the generated router
+// failed to bind using the same body as every other error.
+func HandleParamError(w http.ResponseWriter, r *http.Request, err error) {
+	writeError(w, r, http.StatusBadRequest, newError(r, spec.ErrorCodeInvalidRequest, err.Error()))
+}
+
+// writeError renders e for code outside of the generated handlers.
+func writeError(w http.ResponseWriter, r *http.Request, code int, e spec.Error) {
+	render.Status(r, code)
+	render.JSON(w, r, e)
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+)
+
+// etag formats the version column of a row as a strong entity tag.
+func etag(version int32) string {
+	return `"` + strconv.FormatInt(int64(version), 10) + `"`
+}
+
+// matchesETag reports whether the If-Match header value ifMatch lists the
+// entity tag of version. Weak tags are compared by their opaque value, and
+// "*" matches any version.
+func matchesETag(ifMatch string, version int32) bool {
+	want := etag(version)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == want {
+			return true
+		}
+	}
+	return false
+}
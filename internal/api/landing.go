@@ -0,0 +1,251 @@
+package api
+
+import (
+	"html/template"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// landingTemplate is the page shown when the links of the e-mails are
+// opened in a browser. Pages asking to confirm have a button posting the
+// form to Action with the token of the link, since browsers only follow
+// links with GET.
+var landingTemplate = template.Must(template.New("landing").Parse(`<!DOCTYPE html>
+<html lang="{{.Locale}}">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{.Title}}</title>
+</head>
+<body style="margin:0;padding:48px 24px;background:#f4f4f5;font-family:Arial,Helvetica,sans-serif;color:#18181b;">
+<main style="max-width:480px;margin:0 auto;padding:32px;background:#ffffff;border-radius:8px;text-align:center;">
+<h1 style="font-size:24px;">{{.Title}}</h1>
+<p style="font-size:16px;line-height:24px;">{{.Message}}</p>
+{{- if .Action}}
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="token" value="{{.Token}}">
+<button type="submit" style="padding:12px 24px;border:0;border-radius:6px;background:#18181b;color:#ffffff;font-size:16px;cursor:pointer;">{{.Button}}</button>
+</form>
+{{- end}}
+{{- if .ProfileURL}}
+<p style="font-size:14px;"><a href="{{.ProfileURL}}" style="color:#18181b;">{{.ProfileLabel}}</a></p>
+{{- end}}
+</main>
+</body>
+</html>
+`))
+
+// landingPage describes the outcome of a link, or asks to confirm it when
+// it has a Button.
+type landingPage struct {
+	Title   string
+	Message string
+	Button  string
+}
+
+// landingPages holds the page of each outcome of a link, by locale and
+// status code. Status codes without a page use the one of 0.
+type landingPages map[pgstore.Locale]map[int]landingPage
+
+// pageLocales are the locales pages are written in.
+var pageLocales = []pgstore.Locale{pgstore.LocalePtBR, pgstore.LocaleEn}
+
+var tripConfirmPrompts = map[pgstore.Locale]landingPage{
+	pgstore.LocalePtBR: {"Confirmar viagem", "Confirme a viagem para enviar os convites aos participantes.", "Confirmar viagem"},
+	pgstore.LocaleEn:   {"Confirm your trip", "Confirm the trip to send the invitations to the participants.", "Confirm trip"},
+}
+
+var tripConfirmPages = landingPages{
+	pgstore.LocalePtBR: {
+		http.StatusNoContent: {"Viagem confirmada", "Sua viagem foi confirmada e os convites estão sendo enviados aos participantes.", ""},
+		http.StatusConflict:  {"Viagem já confirmada", "Esta viagem já tinha sido confirmada.", ""},
+		http.StatusNotFound:  {"Viagem não encontrada", "Esta viagem não existe mais.", ""},
+		0:                    {"Não foi possível confirmar", "O link é inválido ou algo deu errado. Tente novamente mais tarde.", ""},
+	},
+	pgstore.LocaleEn: {
+		http.StatusNoContent: {"Trip confirmed", "Your trip is confirmed and the invitations are being sent to the participants.", ""},
+		http.StatusConflict:  {"Trip already confirmed", "This trip had already been confirmed.", ""},
+		http.StatusNotFound:  {"Trip not found", "This trip no longer exists.", ""},
+		0:                    {"Could not confirm", "The link is invalid or something went wrong. Please try again later.", ""},
+	},
+}
+
+var participantConfirmPrompts = map[pgstore.Locale]landingPage{
+	pgstore.LocalePtBR: {"Confirmar presença", "Confirme sua presença na viagem.", "Confirmar presença"},
+	pgstore.LocaleEn:   {"Confirm attendance", "Confirm your attendance at the trip.", "Confirm attendance"},
+}
+
+var participantConfirmPages = landingPages{
+	pgstore.LocalePtBR: {
+		http.StatusNoContent: {"Presença confirmada", "Sua presença na viagem foi confirmada. Até breve!", ""},
+		http.StatusConflict:  {"Não foi possível confirmar", "Você já tinha confirmado sua presença ou foi removido desta viagem.", ""},
+		http.StatusNotFound:  {"Convite não encontrado", "Este convite ou a viagem não existem mais.", ""},
+		0:                    {"Não foi possível confirmar", "O link é inválido ou algo deu errado. Tente novamente mais tarde.", ""},
+	},
+	pgstore.LocaleEn: {
+		http.StatusNoContent: {"Attendance confirmed", "Your attendance at the trip is confirmed. See you soon!", ""},
+		http.StatusConflict:  {"Could not confirm", "You had already confirmed your attendance or were removed from this trip.", ""},
+		http.StatusNotFound:  {"Invitation not found", "This invitation or its trip no longer exist.", ""},
+		0:                    {"Could not confirm", "The link is invalid or something went wrong. Please try again later.", ""},
+	},
+}
+
+// profileLabels names the link of the participant pages to their profile.
+var profileLabels = map[pgstore.Locale]string{
+	pgstore.LocalePtBR: "Ver seu perfil",
+	pgstore.LocaleEn:   "See your profile",
+}
+
+// landingData holds the fields landingTemplate uses.
+type landingData struct {
+	landingPage
+	Locale       pgstore.Locale
+	Action       string
+	Token        string
+	ProfileURL   string
+	ProfileLabel string
+}
+
+// wantsHTML reports whether r comes from a browser, which asks for HTML.
+func wantsHTML(r *http.Request) bool {
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		if mediaType, _, err := mime.ParseMediaType(accept); err == nil && mediaType == "text/html" {
+			return true
+		}
+	}
+	return false
+}
+
+// pageLocale picks the locale of a page: stored, the one of the trip or
+// participant the link was sent for, when pages are written in it, or else
+// the one of the Accept-Language header of r, falling back to pt-BR.
+func pageLocale(r *http.Request, stored pgstore.Locale) pgstore.Locale {
+	if slices.Contains(pageLocales, stored) {
+		return stored
+	}
+
+	for _, tag := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, _, _ = strings.Cut(strings.TrimSpace(tag), ";")
+		switch lang, _, _ := strings.Cut(strings.ToLower(tag), "-"); lang {
+		case "pt":
+			return pgstore.LocalePtBR
+		case "en":
+			return pgstore.LocaleEn
+		}
+	}
+	return pgstore.LocalePtBR
+}
+
+// tripPageLocale returns the locale of the trip with tripID, if the token
+// of r was issued for it.
+func (ap *API) tripPageLocale(r *http.Request, tripID string) pgstore.Locale {
+	id, err := uuid.Parse(tripID)
+	if err != nil || !canActOnTrip(r, id) {
+		return ""
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), id)
+	if err != nil {
+		return ""
+	}
+	return trip.Locale
+}
+
+// participantPageLocale returns the locale of the participant with
+// participantID, or the one of their trip when they have none, if the
+// token of r was issued to them.
+func (ap *API) participantPageLocale(r *http.Request, participantID string) pgstore.Locale {
+	id, err := uuid.Parse(participantID)
+	if err != nil || !canActAsParticipant(r, id) {
+		return ""
+	}
+
+	participant, err := ap.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		return ""
+	}
+	if participant.Locale.Valid {
+		return participant.Locale.Locale
+	}
+
+	trip, err := ap.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		return ""
+	}
+	return trip.Locale
+}
+
+// profileURL returns the link to the profile of the participant with
+// participantID under the public base URL, carrying the token of r, if it
+// was issued to them.
+func (ap *API) profileURL(r *http.Request, participantID string) string {
+	id, err := uuid.Parse(participantID)
+	if err != nil || !canActAsParticipant(r, id) {
+		return ""
+	}
+
+	u, err := url.JoinPath(ap.baseURL, "participants", id.String(), "profile")
+	if err != nil {
+		ap.logger.Error("failed to build profile link", zap.Error(err))
+		return ""
+	}
+	return u + "?token=" + url.QueryEscape(requestToken(r))
+}
+
+// renderPrompt writes the page of prompts asking to confirm the link r
+// was opened for, with a button posting its token to the same path, so it
+// isn't repeated in the URL of the form.
+func (ap *API) renderPrompt(w http.ResponseWriter, r *http.Request, locale pgstore.Locale, prompts map[pgstore.Locale]landingPage, profile string) {
+	ap.writeLanding(w, http.StatusOK, landingData{
+		landingPage:  prompts[locale],
+		Locale:       locale,
+		Action:       r.URL.Path,
+		Token:        requestToken(r),
+		ProfileURL:   profile,
+		ProfileLabel: profileLabels[locale],
+	})
+}
+
+// renderLanding writes the page of pages describing resp, the outcome of
+// the operation a link was confirmed for. The status code of resp is kept,
+// except for 204 which can't carry the page.
+func (ap *API) renderLanding(w http.ResponseWriter, locale pgstore.Locale, pages landingPages, resp *spec.Response, profile string) {
+	page, ok := pages[locale][resp.Code]
+	if !ok {
+		page = pages[locale][0]
+	}
+
+	code := resp.Code
+	if code == http.StatusNoContent {
+		code = http.StatusOK
+	}
+	if code == http.StatusNotFound {
+		profile = ""
+	}
+
+	ap.writeLanding(w, code, landingData{
+		landingPage:  page,
+		Locale:       locale,
+		ProfileURL:   profile,
+		ProfileLabel: profileLabels[locale],
+	})
+}
+
+func (ap *API) writeLanding(w http.ResponseWriter, code int, data landingData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := landingTemplate.Execute(w, data); err != nil {
+		ap.logger.Error("failed to write landing page", zap.Error(err))
+	}
+}
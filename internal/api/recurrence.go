@@ -0,0 +1,284 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/EyzRyder/Travel-Planner/internal/api/spec"
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// maxRecurrenceInterval is the largest interval a recurrence rule takes.
+const maxRecurrenceInterval = 99
+
+// recurrenceColumns holds the columns storing the recurrence rule of an
+// activity. Activities that don't repeat have no frequency.
+type recurrenceColumns struct {
+	frequency pgstore.NullRecurrenceFrequency
+	interval  int32
+	weekdays  []int16
+	until     pgtype.Date
+}
+
+// noRecurrence returns the columns of an activity that doesn't repeat.
+func noRecurrence() recurrenceColumns {
+	return recurrenceColumns{interval: 1, weekdays: []int16{}}
+}
+
+// toRecurrence converts the recurrence rule of an activity starting at
+// occursAt in loc to its columns. Weekdays are stored as time.Weekday.
+func toRecurrence(r *http.Request, rec spec.ActivityRecurrence, occursAt time.Time, loc *time.Location) (recurrenceColumns, *spec.Error) {
+	cols := noRecurrence()
+
+	cols.frequency = pgstore.NullRecurrenceFrequency{
+		RecurrenceFrequency: pgstore.RecurrenceFrequency(rec.Frequency.ToValue()),
+		Valid:               true,
+	}
+	if rec.Interval != nil {
+		if *rec.Interval < 1 || *rec.Interval > maxRecurrenceInterval {
+			e := fieldError(r, "recurrence.interval", "max", fmt.Sprintf("must be between 1 and %d", maxRecurrenceInterval))
+			return cols, &e
+		}
+		cols.interval = int32(*rec.Interval)
+	}
+
+	if len(rec.Weekdays) > 0 && rec.Frequency != spec.ActivityRecurrenceFrequencyWeekly {
+		e := fieldError(r, "recurrence.weekdays", "excluded_unless", "can only be sent with the weekly frequency")
+		return cols, &e
+	}
+	for _, wd := range rec.Weekdays {
+		d := weekday(wd)
+		if !slices.Contains(cols.weekdays, int16(d)) {
+			cols.weekdays = append(cols.weekdays, int16(d))
+		}
+	}
+	slices.Sort(cols.weekdays)
+
+	if rec.Until != nil {
+		if dateIn(*rec.Until, loc).Before(startOfDay(occursAt, loc)) {
+			e := fieldError(r, "recurrence.until", "gtefield", "must not be before occurs_at")
+			return cols, &e
+		}
+		cols.until = pgtype.Date{Time: rec.Until.Time, Valid: true}
+	}
+
+	return cols, nil
+}
+
+// checkSeries validates the occurrences of the recurring activity act of
+// trip after its time or rule changed: the rule can't end before the first
+// occurrence, and at least one occurrence must fall within the trip dates.
+func checkSeries(r *http.Request, trip pgstore.Trip, act pgstore.Activity) *spec.Error {
+	if !act.RecurrenceFrequency.Valid {
+		return nil
+	}
+
+	loc := act.Zone(trip)
+	if act.RecurrenceUntil.Valid && civilDay(act.RecurrenceUntil.Time) < civilDay(act.OccursAt.Time.In(loc)) {
+		e := fieldError(r, "recurrence.until", "gtefield", "must not be before occurs_at")
+		return &e
+	}
+
+	startsAt, endsAt := trip.StartsAt.Time.In(loc), trip.EndsAt.Time.In(loc)
+	if len(expandRecurrence(act, loc, startsAt, endsAt, nil)) == 0 {
+		e := fieldError(r, "occurs_at", "within_trip", "recurrence has no occurrence within the trip dates")
+		return &e
+	}
+
+	return nil
+}
+
+// fromRecurrence converts the recurrence columns of act to an optional
+// response field. Weekly activities without weekdays repeat on the day of
+// their first occurrence, which is reported in their place.
+func fromRecurrence(act pgstore.Activity, loc *time.Location) *spec.ActivityRecurrence {
+	if !act.RecurrenceFrequency.Valid {
+		return nil
+	}
+
+	var out spec.ActivityRecurrence
+	_ = out.Frequency.FromValue(string(act.RecurrenceFrequency.RecurrenceFrequency))
+
+	interval := int(act.RecurrenceInterval)
+	out.Interval = &interval
+
+	if out.Frequency == spec.ActivityRecurrenceFrequencyWeekly {
+		for _, d := range activityWeekdays(act, loc) {
+			var wd spec.Weekday
+			_ = wd.FromValue(strings.ToLower(d.String()))
+			out.Weekdays = append(out.Weekdays, wd)
+		}
+	}
+
+	if act.RecurrenceUntil.Valid {
+		out.Until = &openapi_types.Date{Time: act.RecurrenceUntil.Time}
+	}
+
+	return &out
+}
+
+// weekday converts a day of the week to the value stored for it. The API
+// values are the lowercase names of time.Weekday.
+func weekday(wd spec.Weekday) time.Weekday {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == wd.ToValue() {
+			return d
+		}
+	}
+	return time.Sunday
+}
+
+// activityWeekdays returns the days of the week a weekly activity happens
+// on, defaulting to the day of its first occurrence in loc.
+func activityWeekdays(act pgstore.Activity, loc *time.Location) []time.Weekday {
+	if len(act.RecurrenceWeekdays) == 0 {
+		return []time.Weekday{act.OccursAt.Time.In(loc).Weekday()}
+	}
+
+	days := make([]time.Weekday, len(act.RecurrenceWeekdays))
+	for i, d := range act.RecurrenceWeekdays {
+		days[i] = time.Weekday(d)
+	}
+	return days
+}
+
+// civilDay numbers the calendar day of t in its own location, so days can
+// be counted without being thrown off by daylight saving changes.
+func civilDay(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// recursOn reports whether the recurring activity act has an occurrence on
+// day, midnight of a calendar day in loc. Weekly intervals are counted in
+// weeks starting on Sunday, from the week of the first occurrence.
+func recursOn(act pgstore.Activity, loc *time.Location, day time.Time) bool {
+	if !act.RecurrenceFrequency.Valid {
+		return false
+	}
+
+	first := startOfDay(act.OccursAt.Time, loc)
+	if day.Before(first) {
+		return false
+	}
+	if act.RecurrenceUntil.Valid && civilDay(day) > civilDay(act.RecurrenceUntil.Time) {
+		return false
+	}
+
+	interval := max(int(act.RecurrenceInterval), 1)
+	switch act.RecurrenceFrequency.RecurrenceFrequency {
+	case pgstore.RecurrenceFrequencyDaily:
+		return (civilDay(day)-civilDay(first))%interval == 0
+	case pgstore.RecurrenceFrequencyWeekly:
+		if !slices.Contains(activityWeekdays(act, loc), day.Weekday()) {
+			return false
+		}
+		weekStart := civilDay(day) - int(day.Weekday())
+		firstWeekStart := civilDay(first) - int(first.Weekday())
+		return ((weekStart-firstWeekStart)/7)%interval == 0
+	}
+
+	return false
+}
+
+// occurrenceOn returns the occurrence of the recurring activity act on day,
+// midnight of a calendar day in loc. It happens at the same local time as
+// the first one and lasts as long.
+func occurrenceOn(act pgstore.Activity, loc *time.Location, day time.Time) (pgstore.Activity, bool) {
+	if !recursOn(act, loc, day) {
+		return act, false
+	}
+
+	start := act.OccursAt.Time.In(loc)
+	occursAt := time.Date(
+		day.Year(), day.Month(), day.Day(),
+		start.Hour(), start.Minute(), start.Second(), start.Nanosecond(),
+		loc,
+	)
+
+	occurrence := act
+	occurrence.OccursAt = pgtype.Timestamptz{Time: occursAt, Valid: true}
+	if act.EndsAt.Valid {
+		occurrence.EndsAt = pgtype.Timestamptz{Time: occursAt.Add(act.EndsAt.Time.Sub(act.OccursAt.Time)), Valid: true}
+	}
+
+	return occurrence, true
+}
+
+// occurrence is a single time a recurring activity happens, on date in the
+// activity time zone.
+type occurrence struct {
+	date     time.Time
+	activity pgstore.Activity
+}
+
+// expandRecurrence lists the occurrences of the recurring activity act
+// between startsAt and endsAt, in order. exceptions holds the changes made
+// to single occurrences by their date: cancelled ones are left out and
+// modified ones take the values they were given.
+func expandRecurrence(
+	act pgstore.Activity,
+	loc *time.Location,
+	startsAt, endsAt time.Time,
+	exceptions map[string]pgstore.ActivityException,
+) []occurrence {
+	var output []occurrence
+
+	for day := startOfDay(startsAt, loc); !day.After(endsAt); day = day.AddDate(0, 0, 1) {
+		o, ok := occurrenceOn(act, loc, day)
+		if !ok || o.OccursAt.Time.Before(startsAt) || o.OccursAt.Time.After(endsAt) {
+			continue
+		}
+
+		if e, ok := exceptions[day.Format(time.DateOnly)]; ok {
+			if e.IsCancelled {
+				continue
+			}
+			o = applyException(o, e)
+		}
+
+		output = append(output, occurrence{date: day, activity: o})
+	}
+
+	return output
+}
+
+// exceptionsByActivity indexes exceptions by activity and occurrence date,
+// as expandRecurrence takes them.
+func exceptionsByActivity(exceptions []pgstore.ActivityException) map[uuid.UUID]map[string]pgstore.ActivityException {
+	changed := make(map[uuid.UUID]map[string]pgstore.ActivityException)
+	for _, e := range exceptions {
+		if changed[e.ActivityID] == nil {
+			changed[e.ActivityID] = make(map[string]pgstore.ActivityException)
+		}
+		changed[e.ActivityID][e.OccurrenceDate.Time.Format(time.DateOnly)] = e
+	}
+	return changed
+}
+
+// applyException overrides the values of an occurrence changed on its own.
+// An occurrence moved without a new end keeps its duration.
+func applyException(o pgstore.Activity, e pgstore.ActivityException) pgstore.Activity {
+	if e.Title.Valid {
+		o.Title = e.Title.String
+	}
+	if e.OccursAt.Valid {
+		if o.EndsAt.Valid {
+			o.EndsAt.Time = e.OccursAt.Time.Add(o.EndsAt.Time.Sub(o.OccursAt.Time))
+		}
+		o.OccursAt = e.OccursAt
+	}
+	if e.EndsAt.Valid {
+		o.EndsAt = e.EndsAt
+	}
+	if e.Location.Valid {
+		o.Location = e.Location
+	}
+	return o
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestExpandRecurrence(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	// at returns hour:minute on day d of July 2024 in loc. July 1 is a Monday.
+	at := func(loc *time.Location, d, hour, minute int) time.Time {
+		return time.Date(2024, time.July, d, hour, minute, 0, 0, loc)
+	}
+	timestamptz := func(t time.Time) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: t, Valid: true}
+	}
+	until := func(d int) pgtype.Date {
+		return pgtype.Date{Time: at(time.UTC, d, 0, 0), Valid: true}
+	}
+	activity := func(loc *time.Location, d int, frequency pgstore.RecurrenceFrequency, interval int32, weekdays ...time.Weekday) pgstore.Activity {
+		act := pgstore.Activity{
+			ID:                 uuid.New(),
+			Title:              "Walk",
+			OccursAt:           timestamptz(at(loc, d, 9, 0)),
+			EndsAt:             timestamptz(at(loc, d, 10, 0)),
+			RecurrenceInterval: interval,
+		}
+		if frequency != "" {
+			act.RecurrenceFrequency = pgstore.NullRecurrenceFrequency{RecurrenceFrequency: frequency, Valid: true}
+		}
+		for _, wd := range weekdays {
+			act.RecurrenceWeekdays = append(act.RecurrenceWeekdays, int16(wd))
+		}
+		return act
+	}
+	withUntil := func(act pgstore.Activity, d int) pgstore.Activity {
+		act.RecurrenceUntil = until(d)
+		return act
+	}
+
+	type want struct {
+		day      int
+		occursAt time.Time
+		endsAt   time.Time
+		title    string
+	}
+	walk := func(loc *time.Location, d int) want {
+		return want{d, at(loc, d, 9, 0), at(loc, d, 10, 0), "Walk"}
+	}
+
+	daily, weekly := pgstore.RecurrenceFrequencyDaily, pgstore.RecurrenceFrequencyWeekly
+	tests := []struct {
+		name       string
+		act        pgstore.Activity
+		loc        *time.Location
+		exceptions map[string]pgstore.ActivityException
+		want       []want
+	}{
+		{
+			name: "does not repeat",
+			act:  activity(time.UTC, 1, "", 1),
+			loc:  time.UTC,
+		},
+		{
+			name: "daily until",
+			act:  withUntil(activity(time.UTC, 1, daily, 1), 4),
+			loc:  time.UTC,
+			want: []want{walk(time.UTC, 1), walk(time.UTC, 2), walk(time.UTC, 3), walk(time.UTC, 4)},
+		},
+		{
+			name: "every three days",
+			act:  activity(time.UTC, 1, daily, 3),
+			loc:  time.UTC,
+			want: []want{walk(time.UTC, 1), walk(time.UTC, 4), walk(time.UTC, 7), walk(time.UTC, 10), walk(time.UTC, 13)},
+		},
+		{
+			name: "every other day from before the trip",
+			act:  withUntil(activity(time.UTC, -5, daily, 2), 5),
+			loc:  time.UTC,
+			want: []want{walk(time.UTC, 1), walk(time.UTC, 3), walk(time.UTC, 5)},
+		},
+		{
+			name: "weekly on the day of the first occurrence",
+			act:  activity(time.UTC, 1, weekly, 1),
+			loc:  time.UTC,
+			want: []want{walk(time.UTC, 1), walk(time.UTC, 8)},
+		},
+		{
+			name: "every other week on weekdays",
+			act:  activity(time.UTC, 1, weekly, 2, time.Monday, time.Wednesday, time.Sunday),
+			loc:  time.UTC,
+			want: []want{walk(time.UTC, 1), walk(time.UTC, 3), walk(time.UTC, 14)},
+		},
+		{
+			name: "local time in the activity zone",
+			act:  withUntil(activity(saoPaulo, 1, daily, 1), 2),
+			loc:  saoPaulo,
+			want: []want{walk(saoPaulo, 1), walk(saoPaulo, 2)},
+		},
+		{
+			name: "cancelled and modified occurrences",
+			act:  withUntil(activity(time.UTC, 1, daily, 1), 4),
+			loc:  time.UTC,
+			exceptions: map[string]pgstore.ActivityException{
+				"2024-07-02": {IsCancelled: true},
+				"2024-07-03": {
+					Title:    pgtype.Text{String: "Late walk", Valid: true},
+					OccursAt: timestamptz(at(time.UTC, 3, 18, 30)),
+				},
+				"2024-07-04": {
+					EndsAt:   timestamptz(at(time.UTC, 4, 12, 0)),
+					Location: pgtype.Text{String: "Beach", Valid: true},
+				},
+				"2024-07-20": {IsCancelled: true},
+			},
+			want: []want{
+				walk(time.UTC, 1),
+				{3, at(time.UTC, 3, 18, 30), at(time.UTC, 3, 19, 30), "Late walk"},
+				{4, at(time.UTC, 4, 9, 0), at(time.UTC, 4, 12, 0), "Walk"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startsAt := at(tt.loc, 1, 0, 0)
+			endsAt := at(tt.loc, 14, 23, 59)
+
+			got := expandRecurrence(tt.act, tt.loc, startsAt, endsAt, tt.exceptions)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expandRecurrence returned %d occurrences, want %d: %v", len(got), len(tt.want), got)
+			}
+
+			for i, o := range got {
+				w := tt.want[i]
+				if !o.date.Equal(at(tt.loc, w.day, 0, 0)) {
+					t.Errorf("occurrence %d date = %v, want July %d", i, o.date, w.day)
+				}
+				if !o.activity.OccursAt.Time.Equal(w.occursAt) || !o.activity.EndsAt.Time.Equal(w.endsAt) {
+					t.Errorf("occurrence %d = %v to %v, want %v to %v",
+						i, o.activity.OccursAt.Time, o.activity.EndsAt.Time, w.occursAt, w.endsAt,
+					)
+				}
+				if o.activity.Title != w.title {
+					t.Errorf("occurrence %d title = %q, want %q", i, o.activity.Title, w.title)
+				}
+			}
+		})
+	}
+}
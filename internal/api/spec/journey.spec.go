@@ -6,6 +6,7 @@ package spec
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
@@ -23,15 +24,207 @@ import (
 	"github.com/go-chi/render"
 )
 
+const (
+	InboundSecretScopes = "inboundSecret.Scopes"
+	MagicLinkScopes     = "magicLink.Scopes"
+)
+
+// Defines values for ActivityCategory.
+var (
+	UnknownActivityCategory = ActivityCategory{}
+
+	ActivityCategoryFood = ActivityCategory{"food"}
+
+	ActivityCategoryLodging = ActivityCategory{"lodging"}
+
+	ActivityCategoryOther = ActivityCategory{"other"}
+
+	ActivityCategorySightseeing = ActivityCategory{"sightseeing"}
+
+	ActivityCategoryTransport = ActivityCategory{"transport"}
+)
+
+// Defines values for ActivityRecurrenceFrequency.
+var (
+	UnknownActivityRecurrenceFrequency = ActivityRecurrenceFrequency{}
+
+	ActivityRecurrenceFrequencyDaily = ActivityRecurrenceFrequency{"daily"}
+
+	ActivityRecurrenceFrequencyWeekly = ActivityRecurrenceFrequency{"weekly"}
+)
+
+// Defines values for ActivityWarningCode.
+var (
+	UnknownActivityWarningCode = ActivityWarningCode{}
+
+	ActivityWarningCodeOverlap = ActivityWarningCode{"overlap"}
+)
+
+// Defines values for ErrorCode.
+var (
+	UnknownErrorCode = ErrorCode{}
+
+	ErrorCodeConflict = ErrorCode{"conflict"}
+
+	ErrorCodeForbidden = ErrorCode{"forbidden"}
+
+	ErrorCodeInternal = ErrorCode{"internal"}
+
+	ErrorCodeInvalidRequest = ErrorCode{"invalid_request"}
+
+	ErrorCodeNotFound = ErrorCode{"not_found"}
+
+	ErrorCodePreconditionFailed = ErrorCode{"precondition_failed"}
+
+	ErrorCodePreconditionRequired = ErrorCode{"precondition_required"}
+
+	ErrorCodeUnauthorized = ErrorCode{"unauthorized"}
+
+	ErrorCodeValidationFailed = ErrorCode{"validation_failed"}
+)
+
+// Defines values for Locale.
+var (
+	UnknownLocale = Locale{}
+
+	LocaleEn = Locale{"en"}
+
+	LocalePtBR = Locale{"pt-BR"}
+)
+
+// Defines values for ParticipantRole.
+var (
+	UnknownParticipantRole = ParticipantRole{}
+
+	ParticipantRoleCoOrganizer = ParticipantRole{"co_organizer"}
+
+	ParticipantRoleParticipant = ParticipantRole{"participant"}
+
+	ParticipantRoleViewer = ParticipantRole{"viewer"}
+)
+
+// Defines values for RSVPStatus.
+var (
+	UnknownRSVPStatus = RSVPStatus{}
+
+	RSVPStatusAccepted = RSVPStatus{"accepted"}
+
+	RSVPStatusDeclined = RSVPStatus{"declined"}
+
+	RSVPStatusInvited = RSVPStatus{"invited"}
+
+	RSVPStatusMaybe = RSVPStatus{"maybe"}
+
+	RSVPStatusRemoved = RSVPStatus{"removed"}
+)
+
+// Defines values for SkippedEventReason.
+var (
+	UnknownSkippedEventReason = SkippedEventReason{}
+
+	SkippedEventReasonDuplicate = SkippedEventReason{"duplicate"}
+
+	SkippedEventReasonInvalid = SkippedEventReason{"invalid"}
+
+	SkippedEventReasonOutsideRange = SkippedEventReason{"outside_range"}
+)
+
+// Defines values for Weekday.
+var (
+	UnknownWeekday = Weekday{}
+
+	WeekdayFriday = Weekday{"friday"}
+
+	WeekdayMonday = Weekday{"monday"}
+
+	WeekdaySaturday = Weekday{"saturday"}
+
+	WeekdaySunday = Weekday{"sunday"}
+
+	WeekdayThursday = Weekday{"thursday"}
+
+	WeekdayTuesday = Weekday{"tuesday"}
+
+	WeekdayWednesday = Weekday{"wednesday"}
+)
+
+// ActivityCost defines model for ActivityCost.
+type ActivityCost struct {
+	// Stored with 2 decimal places, up to 9999999999.99.
+	Amount float64 `json:"amount" validate:"gte=0,lte=9999999999.99"`
+
+	// ISO 4217 currency code, e.g. BRL.
+	Currency string `json:"currency" validate:"required,iso4217"`
+}
+
+// Repeats the activity at the same local time, stored once and expanded within the trip dates.
+type ActivityRecurrence struct {
+	Frequency ActivityRecurrenceFrequency `json:"frequency"`
+
+	// Repeats every interval days, or weeks when weekly. Defaults to 1.
+	Interval *int `json:"interval" validate:"omitnil,min=1,max=99"`
+
+	// Last day, in the activity time zone, an occurrence can happen on.
+	Until *openapi_types.Date `json:"until"`
+
+	// Days of the week a weekly activity happens on. Defaults to the day of occurs_at.
+	Weekdays []Weekday `json:"weekdays,omitempty"`
+}
+
+// ActivityWarning defines model for ActivityWarning.
+type ActivityWarning struct {
+	ActivityID string              `json:"activity_id"`
+	Code       ActivityWarningCode `json:"code"`
+	Message    string              `json:"message"`
+}
+
+// CalendarSubscription defines model for CalendarSubscription.
+type CalendarSubscription struct {
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Calendar token held by the URL.
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// CreateActivitiesRequest defines model for CreateActivitiesRequest.
+type CreateActivitiesRequest struct {
+	Activities []CreateActivityRequest `json:"activities" validate:"required,min=1,max=500"`
+}
+
+// CreateActivitiesResponse defines model for CreateActivitiesResponse.
+type CreateActivitiesResponse struct {
+	// Results in the order the activities were sent.
+	Activities []CreatedActivity `json:"activities"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
-	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+	Address          *string           `json:"address" validate:"omitnil,max=500"`
+	BookingReference *string           `json:"booking_reference" validate:"omitnil,max=255"`
+	Category         *ActivityCategory `json:"category,omitempty"`
+
+	// Sets ends_at from occurs_at. Can't be sent with ends_at.
+	DurationMinutes *int          `json:"duration_minutes" validate:"omitnil,min=1"`
+	EndsAt          *time.Time    `json:"ends_at"`
+	EstimatedCost   *ActivityCost `json:"estimated_cost,omitempty"`
+	Latitude        *float64      `json:"latitude" validate:"omitnil,gte=-90,lte=90"`
+	Location        *string       `json:"location" validate:"omitnil,max=255"`
+	Longitude       *float64      `json:"longitude" validate:"omitnil,gte=-180,lte=180"`
+	OccursAt        time.Time     `json:"occurs_at" validate:"required"`
+
+	// Repeats the activity at the same local time, stored once and expanded within the trip dates.
+	Recurrence *ActivityRecurrence `json:"recurrence,omitempty"`
+
+	// IANA time zone, e.g. Asia/Tokyo. Defaults to the time zone of the trip.
+	TimeZone *string `json:"time_zone" validate:"omitnil,timezone"`
+	Title    string  `json:"title" validate:"required,max=255"`
 }
 
 // CreateActivityResponse defines model for CreateActivityResponse.
 type CreateActivityResponse struct {
-	ActivityID string `json:"activityId"`
+	ActivityID string            `json:"activityId"`
+	Warnings   []ActivityWarning `json:"warnings"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
@@ -50,9 +243,15 @@ type CreateTripRequest struct {
 	Destination    string                `json:"destination" validate:"required,min=4"`
 	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
 	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+
+	// Language of the e-mails.
+	Locale     *Locale             `json:"locale,omitempty"`
+	OwnerEmail openapi_types.Email `json:"owner_email" validate:"required,email"`
+	OwnerName  string              `json:"owner_name" validate:"required"`
+	StartsAt   time.Time           `json:"starts_at" validate:"required"`
+
+	// IANA time zone, e.g. Asia/Tokyo. Defaults to UTC.
+	TimeZone *string `json:"time_zone" validate:"omitnil,timezone"`
 }
 
 // CreateTripResponse defines model for CreateTripResponse.
@@ -60,9 +259,29 @@ type CreateTripResponse struct {
 	TripID string `json:"tripId"`
 }
 
-// Bad request
+// CreatedActivity defines model for CreatedActivity.
+type CreatedActivity struct {
+	// Null when the activity was not created.
+	ActivityID *string `json:"activity_id"`
+
+	// Error response
+	Error    *Error            `json:"error,omitempty"`
+	Warnings []ActivityWarning `json:"warnings"`
+}
+
+// Error response
 type Error struct {
+	Code      ErrorCode    `json:"code"`
+	Fields    []FieldError `json:"fields,omitempty"`
+	Message   string       `json:"message"`
+	RequestID string       `json:"request_id"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
+	Rule    string `json:"rule"`
 }
 
 // GetLinksResponse defines model for GetLinksResponse.
@@ -72,21 +291,51 @@ type GetLinksResponse struct {
 
 // GetLinksResponseArray defines model for GetLinksResponseArray.
 type GetLinksResponseArray struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	URL   string `json:"url"`
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Version int    `json:"version"`
 }
 
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
 type GetTripActivitiesResponse struct {
+	// One entry per calendar day of the trip, in order, even those without activities.
 	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+
+	// Activities that no longer fall between the trip starts_at and ends_at.
+	OutsideRange []GetTripActivitiesResponseInnerArray `json:"outside_range"`
 }
 
 // GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
 type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
-	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+	Address          *string           `json:"address"`
+	BookingReference *string           `json:"booking_reference"`
+	Category         *ActivityCategory `json:"category,omitempty"`
+	DurationMinutes  *int              `json:"duration_minutes"`
+	EndsAt           *time.Time        `json:"ends_at"`
+
+	// ends_at in the activity time zone.
+	EndsAtLocal   *time.Time    `json:"ends_at_local"`
+	EstimatedCost *ActivityCost `json:"estimated_cost,omitempty"`
+	ID            string        `json:"id"`
+	Latitude      *float64      `json:"latitude"`
+	Location      *string       `json:"location"`
+	Longitude     *float64      `json:"longitude"`
+
+	// Day of the series this occurrence was generated for, in the activity time zone.
+	OccurrenceDate *openapi_types.Date `json:"occurrence_date"`
+	OccursAt       time.Time           `json:"occurs_at"`
+
+	// occurs_at in the activity time zone.
+	OccursAtLocal time.Time `json:"occurs_at_local"`
+
+	// Repeats the activity at the same local time, stored once and expanded within the trip dates.
+	Recurrence *ActivityRecurrence `json:"recurrence,omitempty"`
+
+	// Time zone of the activity, the one of the trip unless it has its own.
+	TimeZone string `json:"time_zone"`
+	Title    string `json:"title"`
+	Version  int    `json:"version"`
 }
 
 // GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
@@ -102,11 +351,27 @@ type GetTripDetailsResponse struct {
 
 // GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
 type GetTripDetailsResponseTripObj struct {
-	Destination string    `json:"destination"`
-	EndsAt      time.Time `json:"ends_at"`
+	ActivitiesCount            int64     `json:"activities_count"`
+	ConfirmedParticipantsCount int64     `json:"confirmed_participants_count"`
+	Destination                string    `json:"destination"`
+	EndsAt                     time.Time `json:"ends_at"`
+
+	// ends_at in the trip time zone.
+	EndsAtLocal time.Time `json:"ends_at_local"`
 	ID          string    `json:"id"`
 	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+	LinksCount  int64     `json:"links_count"`
+
+	// Language of the e-mails.
+	Locale            Locale              `json:"locale"`
+	OwnerEmail        openapi_types.Email `json:"owner_email"`
+	OwnerName         string              `json:"owner_name"`
+	ParticipantsCount int64               `json:"participants_count"`
+	StartsAt          time.Time           `json:"starts_at"`
+
+	// starts_at in the trip time zone.
+	StartsAtLocal time.Time `json:"starts_at_local"`
+	TimeZone      string    `json:"time_zone"`
 }
 
 // GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
@@ -116,10 +381,43 @@ type GetTripParticipantsResponse struct {
 
 // GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
 type GetTripParticipantsResponseArray struct {
-	Email       openapi_types.Email `json:"email"`
-	ID          string              `json:"id"`
-	IsConfirmed bool                `json:"is_confirmed"`
-	Name        *string             `json:"name"`
+	AccessibilityNotes *string             `json:"accessibility_notes"`
+	DietaryNotes       *string             `json:"dietary_notes"`
+	Email              openapi_types.Email `json:"email"`
+	ID                 string              `json:"id"`
+	IsConfirmed        bool                `json:"is_confirmed"`
+
+	// Language of the e-mails sent to the participant, null when it follows the trip.
+	Locale *string `json:"locale"`
+	Name   *string `json:"name"`
+	Phone  *string `json:"phone"`
+
+	// Co-organizers can update the trip and invite people, participants can add activities and links, viewers can only read the trip.
+	Role          ParticipantRole `json:"role"`
+	RsvpNote      *string         `json:"rsvp_note"`
+	RsvpStatus    RSVPStatus      `json:"rsvp_status"`
+	RsvpUpdatedAt time.Time       `json:"rsvp_updated_at"`
+}
+
+// ImportActivitiesResponse defines model for ImportActivitiesResponse.
+type ImportActivitiesResponse struct {
+	// Activities created, or that would be created in a dry run.
+	Activities []ImportedActivity `json:"activities"`
+	DryRun     bool               `json:"dry_run"`
+	Skipped    []SkippedEvent     `json:"skipped"`
+}
+
+// ImportedActivity defines model for ImportedActivity.
+type ImportedActivity struct {
+	// Null in a dry run.
+	ActivityID *string           `json:"activity_id"`
+	Category   *ActivityCategory `json:"category,omitempty"`
+	EndsAt     *time.Time        `json:"ends_at"`
+	Location   *string           `json:"location"`
+	OccursAt   time.Time         `json:"occurs_at"`
+	TimeZone   *string           `json:"time_zone"`
+	Title      string            `json:"title"`
+	UID        string            `json:"uid"`
 }
 
 // InviteParticipantRequest defines model for InviteParticipantRequest.
@@ -127,172 +425,710 @@ type InviteParticipantRequest struct {
 	Email openapi_types.Email `json:"email" validate:"required,email"`
 }
 
+// SkippedEvent defines model for SkippedEvent.
+type SkippedEvent struct {
+	Message string             `json:"message"`
+	Reason  SkippedEventReason `json:"reason"`
+	Title   string             `json:"title"`
+	UID     string             `json:"uid"`
+}
+
+// UpdateActivityRequest defines model for UpdateActivityRequest.
+type UpdateActivityRequest struct {
+	Address          *string           `json:"address" validate:"omitnil,max=500"`
+	BookingReference *string           `json:"booking_reference" validate:"omitnil,max=255"`
+	Category         *ActivityCategory `json:"category,omitempty"`
+
+	// Sets ends_at from occurs_at. Can't be sent with ends_at.
+	DurationMinutes *int          `json:"duration_minutes" validate:"omitnil,min=1"`
+	EndsAt          *time.Time    `json:"ends_at"`
+	EstimatedCost   *ActivityCost `json:"estimated_cost,omitempty"`
+	Latitude        *float64      `json:"latitude" validate:"omitnil,gte=-90,lte=90"`
+	Location        *string       `json:"location" validate:"omitnil,max=255"`
+	Longitude       *float64      `json:"longitude" validate:"omitnil,gte=-180,lte=180"`
+	OccursAt        *time.Time    `json:"occurs_at"`
+
+	// Repeats the activity at the same local time, stored once and expanded within the trip dates.
+	Recurrence *ActivityRecurrence `json:"recurrence,omitempty"`
+
+	// IANA time zone, e.g. Asia/Tokyo. Keeps the current one when omitted.
+	TimeZone *string `json:"time_zone" validate:"omitnil,timezone"`
+	Title    *string `json:"title" validate:"omitnil,min=1,max=255"`
+}
+
+// UpdateActivityResponse defines model for UpdateActivityResponse.
+type UpdateActivityResponse struct {
+	Activity GetTripActivitiesResponseInnerArray `json:"activity"`
+	Warnings []ActivityWarning                   `json:"warnings"`
+}
+
+// UpdateLinkRequest defines model for UpdateLinkRequest.
+type UpdateLinkRequest struct {
+	Title *string `json:"title" validate:"omitnil,min=1"`
+	URL   *string `json:"url" validate:"omitnil,url"`
+}
+
+// UpdateOccurrenceRequest defines model for UpdateOccurrenceRequest.
+type UpdateOccurrenceRequest struct {
+	EndsAt   *time.Time `json:"ends_at"`
+	Location *string    `json:"location" validate:"omitnil,max=255"`
+	OccursAt *time.Time `json:"occurs_at"`
+	Title    *string    `json:"title" validate:"omitnil,min=1,max=255"`
+}
+
+// UpdateParticipantProfileRequest defines model for UpdateParticipantProfileRequest.
+type UpdateParticipantProfileRequest struct {
+	AccessibilityNotes *string `json:"accessibility_notes" validate:"omitnil,max=1000"`
+	DietaryNotes       *string `json:"dietary_notes" validate:"omitnil,max=1000"`
+
+	// Language of the e-mails.
+	Locale *Locale `json:"locale,omitempty"`
+	Name   string  `json:"name" validate:"required,max=255"`
+	Phone  *string `json:"phone" validate:"omitnil,e164"`
+}
+
+// UpdateParticipantRoleRequest defines model for UpdateParticipantRoleRequest.
+type UpdateParticipantRoleRequest struct {
+	// Co-organizers can update the trip and invite people, participants can add activities and links, viewers can only read the trip.
+	Role ParticipantRole `json:"role"`
+}
+
+// UpdateRSVPRequest defines model for UpdateRSVPRequest.
+type UpdateRSVPRequest struct {
+	Note   *string    `json:"note" validate:"omitnil,max=1000"`
+	Status RSVPStatus `json:"status"`
+}
+
 // UpdateTripRequest defines model for UpdateTripRequest.
 type UpdateTripRequest struct {
 	Destination string    `json:"destination" validate:"required,min=4"`
 	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+
+	// Language of the e-mails.
+	Locale   *Locale   `json:"locale,omitempty"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+
+	// IANA time zone, e.g. Asia/Tokyo. Keeps the current one when omitted.
+	TimeZone *string `json:"time_zone" validate:"omitnil,timezone"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// ActivityCategory defines model for ActivityCategory.
+type ActivityCategory struct {
+	value string
+}
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+func (t *ActivityCategory) ToValue() string {
+	return t.value
+}
+func (t ActivityCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ActivityCategory) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ActivityCategory) FromValue(value string) error {
+	switch value {
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+	case ActivityCategoryFood.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+	case ActivityCategoryLodging.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+	case ActivityCategoryOther.value:
+		t.value = value
+		return nil
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+	case ActivityCategorySightseeing.value:
+		t.value = value
+		return nil
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	case ActivityCategoryTransport.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+// ActivityRecurrenceFrequency defines model for ActivityRecurrence.Frequency.
+type ActivityRecurrenceFrequency struct {
+	value string
+}
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+func (t *ActivityRecurrenceFrequency) ToValue() string {
+	return t.value
 }
+func (t ActivityRecurrenceFrequency) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ActivityRecurrenceFrequency) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ActivityRecurrenceFrequency) FromValue(value string) error {
+	switch value {
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+	case ActivityRecurrenceFrequencyDaily.value:
+		t.value = value
+		return nil
 
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	case ActivityRecurrenceFrequencyWeekly.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// ActivityWarningCode defines model for ActivityWarning.Code.
+type ActivityWarningCode struct {
+	value string
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+func (t *ActivityWarningCode) ToValue() string {
+	return t.value
 }
+func (t ActivityWarningCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ActivityWarningCode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *ActivityWarningCode) FromValue(value string) error {
+	switch value {
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+	case ActivityWarningCodeOverlap.value:
+		t.value = value
+		return nil
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// ErrorCode defines model for Error.Code.
+type ErrorCode struct {
+	value string
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+func (t *ErrorCode) ToValue() string {
+	return t.value
 }
-
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+func (t ErrorCode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
 }
-
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+func (t *ErrorCode) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
 }
+func (t *ErrorCode) FromValue(value string) error {
+	switch value {
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+	case ErrorCodeConflict.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeForbidden.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInternal.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeInvalidRequest.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeNotFound.value:
+		t.value = value
+		return nil
+
+	case ErrorCodePreconditionFailed.value:
+		t.value = value
+		return nil
+
+	case ErrorCodePreconditionRequired.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeUnauthorized.value:
+		t.value = value
+		return nil
+
+	case ErrorCodeValidationFailed.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// Language of the e-mails.
+type Locale struct {
+	value string
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+func (t *Locale) ToValue() string {
+	return t.value
+}
+func (t Locale) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *Locale) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *Locale) FromValue(value string) error {
+	switch value {
+
+	case LocaleEn.value:
+		t.value = value
+		return nil
+
+	case LocalePtBR.value:
+		t.value = value
+		return nil
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// Co-organizers can update the trip and invite people, participants can add activities and links, viewers can only read the trip.
+type ParticipantRole struct {
+	value string
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+func (t *ParticipantRole) ToValue() string {
+	return t.value
+}
+func (t ParticipantRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ParticipantRole) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ParticipantRole) FromValue(value string) error {
+	switch value {
+
+	case ParticipantRoleCoOrganizer.value:
+		t.value = value
+		return nil
+
+	case ParticipantRoleParticipant.value:
+		t.value = value
+		return nil
+
+	case ParticipantRoleViewer.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// RSVPStatus defines model for RSVPStatus.
+type RSVPStatus struct {
+	value string
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+func (t *RSVPStatus) ToValue() string {
+	return t.value
+}
+func (t RSVPStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *RSVPStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *RSVPStatus) FromValue(value string) error {
+	switch value {
+
+	case RSVPStatusAccepted.value:
+		t.value = value
+		return nil
+
+	case RSVPStatusDeclined.value:
+		t.value = value
+		return nil
+
+	case RSVPStatusInvited.value:
+		t.value = value
+		return nil
+
+	case RSVPStatusMaybe.value:
+		t.value = value
+		return nil
+
+	case RSVPStatusRemoved.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// SkippedEventReason defines model for SkippedEvent.Reason.
+type SkippedEventReason struct {
+	value string
+}
+
+func (t *SkippedEventReason) ToValue() string {
+	return t.value
+}
+func (t SkippedEventReason) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *SkippedEventReason) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *SkippedEventReason) FromValue(value string) error {
+	switch value {
+
+	case SkippedEventReasonDuplicate.value:
+		t.value = value
+		return nil
+
+	case SkippedEventReasonInvalid.value:
+		t.value = value
+		return nil
+
+	case SkippedEventReasonOutsideRange.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// Weekday defines model for Weekday.
+type Weekday struct {
+	value string
+}
+
+func (t *Weekday) ToValue() string {
+	return t.value
+}
+func (t Weekday) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *Weekday) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *Weekday) FromValue(value string) error {
+	switch value {
+
+	case WeekdayFriday.value:
+		t.value = value
+		return nil
+
+	case WeekdayMonday.value:
+		t.value = value
+		return nil
+
+	case WeekdaySaturday.value:
+		t.value = value
+		return nil
+
+	case WeekdaySunday.value:
+		t.value = value
+		return nil
+
+	case WeekdayThursday.value:
+		t.value = value
+		return nil
+
+	case WeekdayTuesday.value:
+		t.value = value
+		return nil
+
+	case WeekdayWednesday.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// PutParticipantsParticipantIDProfileJSONBody defines parameters for PutParticipantsParticipantIDProfile.
+type PutParticipantsParticipantIDProfileJSONBody UpdateParticipantProfileRequest
+
+// PatchParticipantsParticipantIDRoleJSONBody defines parameters for PatchParticipantsParticipantIDRole.
+type PatchParticipantsParticipantIDRoleJSONBody UpdateParticipantRoleRequest
+
+// PutParticipantsParticipantIDRsvpJSONBody defines parameters for PutParticipantsParticipantIDRsvp.
+type PutParticipantsParticipantIDRsvpJSONBody UpdateRSVPRequest
+
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// Only return activities in one of these categories.
+	Category []string `json:"category,omitempty"`
+
+	// Only return days from this date on, in the trip time zone.
+	From *openapi_types.Date `json:"from,omitempty"`
+
+	// Only return days up to this date, in the trip time zone.
+	To *openapi_types.Date `json:"to,omitempty"`
+}
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// PostTripsTripIDActivitiesParams defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesParams struct {
+	// Reject activities that overlap others with a 409 instead of returning warnings.
+	Strict *bool `json:"strict,omitempty"`
+}
+
+// PostTripsTripIDActivitiesBulkJSONBody defines parameters for PostTripsTripIDActivitiesBulk.
+type PostTripsTripIDActivitiesBulkJSONBody CreateActivitiesRequest
+
+// PostTripsTripIDActivitiesBulkParams defines parameters for PostTripsTripIDActivitiesBulk.
+type PostTripsTripIDActivitiesBulkParams struct {
+	// Reject activities that overlap others instead of returning warnings.
+	Strict *bool `json:"strict,omitempty"`
+
+	// Set to false to create the valid activities even when others fail.
+	Atomic *bool `json:"atomic,omitempty"`
+}
+
+// PostTripsTripIDActivitiesImportParams defines parameters for PostTripsTripIDActivitiesImport.
+type PostTripsTripIDActivitiesImportParams struct {
+	// Return the activities that would be created without creating them.
+	DryRun *bool `json:"dry_run,omitempty"`
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSONBody defines parameters for PatchTripsTripIDActivitiesActivityID.
+type PatchTripsTripIDActivitiesActivityIDJSONBody UpdateActivityRequest
+
+// PatchTripsTripIDActivitiesActivityIDParams defines parameters for PatchTripsTripIDActivitiesActivityID.
+type PatchTripsTripIDActivitiesActivityIDParams struct {
+	// Reject activities that overlap others with a 409 instead of returning warnings.
+	Strict *bool `json:"strict,omitempty"`
+
+	// ETag of the version being edited. Required, a request without it gets a 428.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSONBody defines parameters for PutTripsTripIDActivitiesActivityIDOccurrencesDate.
+type PutTripsTripIDActivitiesActivityIDOccurrencesDateJSONBody UpdateOccurrenceRequest
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PatchTripsTripIDLinksLinkIDJSONBody defines parameters for PatchTripsTripIDLinksLinkID.
+type PatchTripsTripIDLinksLinkIDJSONBody UpdateLinkRequest
+
+// PatchTripsTripIDLinksLinkIDParams defines parameters for PatchTripsTripIDLinksLinkID.
+type PatchTripsTripIDLinksLinkIDParams struct {
+	// ETag of the version being edited. Required, a request without it gets a 428.
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetTripsTripIDParticipantsParams defines parameters for GetTripsTripIDParticipants.
+type GetTripsTripIDParticipantsParams struct {
+	// Only return participants in one of these RSVP states.
+	Rsvp []string `json:"rsvp,omitempty"`
+}
+
+// PutParticipantsParticipantIDProfileJSONRequestBody defines body for PutParticipantsParticipantIDProfile for application/json ContentType.
+type PutParticipantsParticipantIDProfileJSONRequestBody PutParticipantsParticipantIDProfileJSONBody
+
+// Bind implements render.Binder.
+func (PutParticipantsParticipantIDProfileJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDRoleJSONRequestBody defines body for PatchParticipantsParticipantIDRole for application/json ContentType.
+type PatchParticipantsParticipantIDRoleJSONRequestBody PatchParticipantsParticipantIDRoleJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDRoleJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutParticipantsParticipantIDRsvpJSONRequestBody defines body for PutParticipantsParticipantIDRsvp for application/json ContentType.
+type PutParticipantsParticipantIDRsvpJSONRequestBody PutParticipantsParticipantIDRsvpJSONBody
+
+// Bind implements render.Binder.
+func (PutParticipantsParticipantIDRsvpJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesBulkJSONRequestBody defines body for PostTripsTripIDActivitiesBulk for application/json ContentType.
+type PostTripsTripIDActivitiesBulkJSONRequestBody PostTripsTripIDActivitiesBulkJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesBulkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PatchTripsTripIDActivitiesActivityID for application/json ContentType.
+type PatchTripsTripIDActivitiesActivityIDJSONRequestBody PatchTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSONRequestBody defines body for PutTripsTripIDActivitiesActivityIDOccurrencesDate for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDOccurrencesDateJSONRequestBody PutTripsTripIDActivitiesActivityIDOccurrencesDateJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDOccurrencesDateJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDLinksLinkIDJSONRequestBody defines body for PatchTripsTripIDLinksLinkID for application/json ContentType.
+type PatchTripsTripIDLinksLinkIDJSONRequestBody PatchTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+	header      http.Header
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	for key, values := range resp.header {
+		w.Header()[key] = values
+	}
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// Header is a builder method to set a header of a response. Headers are
+// written by Render, so responses without a body don't carry them.
+func (resp *Response) Header(key, value string) *Response {
+	if resp.header == nil {
+		resp.header = make(http.Header)
+	}
+	resp.header.Set(key, value)
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// PostCalendarRepliesJSON204Response is a constructor method for a PostCalendarReplies response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
+func PostCalendarRepliesJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -300,9 +1136,9 @@ func PutTripsTripIDJSON204Response(body interface{}) *Response {
 	}
 }
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// PostCalendarRepliesJSON400Response is a constructor method for a PostCalendarReplies response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
+func PostCalendarRepliesJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -310,49 +1146,49 @@ func PutTripsTripIDJSON400Response(body Error) *Response {
 	}
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// PostCalendarRepliesJSON401Response is a constructor method for a PostCalendarReplies response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+func PostCalendarRepliesJSON401Response(body Error) *Response {
 	return &Response{
 		body:        body,
-		Code:        200,
+		Code:        401,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// PostCalendarRepliesJSON403Response is a constructor method for a PostCalendarReplies response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+func PostCalendarRepliesJSON403Response(body Error) *Response {
 	return &Response{
 		body:        body,
-		Code:        400,
+		Code:        403,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// PostCalendarRepliesJSON404Response is a constructor method for a PostCalendarReplies response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+func PostCalendarRepliesJSON404Response(body Error) *Response {
 	return &Response{
 		body:        body,
-		Code:        201,
+		Code:        404,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// PostCalendarRepliesJSON500Response is a constructor method for a PostCalendarReplies response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+func PostCalendarRepliesJSON500Response(body Error) *Response {
 	return &Response{
 		body:        body,
-		Code:        400,
+		Code:        500,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// DeleteParticipantsParticipantIDJSON204Response is a constructor method for a DeleteParticipantsParticipantID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+func DeleteParticipantsParticipantIDJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -360,9 +1196,9 @@ func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
 	}
 }
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// DeleteParticipantsParticipantIDJSON400Response is a constructor method for a DeleteParticipantsParticipantID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+func DeleteParticipantsParticipantIDJSON400Response(body Error) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -370,143 +1206,2743 @@ func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
 	}
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// DeleteParticipantsParticipantIDJSON401Response is a constructor method for a DeleteParticipantsParticipantID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
+func DeleteParticipantsParticipantIDJSON401Response(body Error) *Response {
 	return &Response{
 		body:        body,
-		Code:        201,
+		Code:        401,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// DeleteParticipantsParticipantIDJSON403Response is a constructor method for a DeleteParticipantsParticipantID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+func DeleteParticipantsParticipantIDJSON403Response(body Error) *Response {
 	return &Response{
 		body:        body,
-		Code:        400,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteParticipantsParticipantIDJSON404Response is a constructor method for a DeleteParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteParticipantsParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteParticipantsParticipantIDJSON500Response is a constructor method for a DeleteParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteParticipantsParticipantIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON401Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON401Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON403Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON404Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON409Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON500Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDConfirmJSON401Response is a constructor method for a PostParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDConfirmJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDProfileJSON200Response is a constructor method for a GetParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDProfileJSON200Response(body GetTripParticipantsResponseArray) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDProfileJSON400Response is a constructor method for a GetParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDProfileJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDProfileJSON401Response is a constructor method for a GetParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDProfileJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDProfileJSON403Response is a constructor method for a GetParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDProfileJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDProfileJSON404Response is a constructor method for a GetParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDProfileJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDProfileJSON500Response is a constructor method for a GetParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDProfileJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDProfileJSON204Response is a constructor method for a PutParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDProfileJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDProfileJSON400Response is a constructor method for a PutParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDProfileJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDProfileJSON401Response is a constructor method for a PutParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDProfileJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDProfileJSON403Response is a constructor method for a PutParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDProfileJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDProfileJSON404Response is a constructor method for a PutParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDProfileJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDProfileJSON500Response is a constructor method for a PutParticipantsParticipantIDProfile response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDProfileJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRoleJSON204Response is a constructor method for a PatchParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRoleJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRoleJSON400Response is a constructor method for a PatchParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRoleJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRoleJSON401Response is a constructor method for a PatchParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRoleJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRoleJSON403Response is a constructor method for a PatchParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRoleJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRoleJSON404Response is a constructor method for a PatchParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRoleJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDRoleJSON500Response is a constructor method for a PatchParticipantsParticipantIDRole response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDRoleJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDRsvpJSON204Response is a constructor method for a PutParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDRsvpJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDRsvpJSON400Response is a constructor method for a PutParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDRsvpJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDRsvpJSON401Response is a constructor method for a PutParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDRsvpJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDRsvpJSON403Response is a constructor method for a PutParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDRsvpJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDRsvpJSON404Response is a constructor method for a PutParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDRsvpJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDRsvpJSON500Response is a constructor method for a PutParticipantsParticipantIDRsvp response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDRsvpJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON500Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON204Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON400Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON401Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON403Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON404Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON500Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON404Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON500Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON401Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON403Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON404Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON409Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON500Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON404Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON500Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON401Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON403Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON404Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON409Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON500Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON201Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON201Response(body CreateActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON400Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON401Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON403Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON404Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON422Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON422Response(body CreateActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBulkJSON500Response is a constructor method for a PostTripsTripIDActivitiesBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBulkJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON200Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON200Response(body ImportActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON201Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON201Response(body ImportActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON400Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON401Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON403Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON404Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportJSON500Response is a constructor method for a PostTripsTripIDActivitiesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON200Response(body GetTripActivitiesResponseInnerArray) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON200Response(body UpdateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON409Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON412Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON412Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        412,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON428Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON428Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        428,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a PatchTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON401Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON409Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON500Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOccurrencesDateJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON401Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON403Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON404Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON409Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON500Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOccurrencesDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOccurrencesDateJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON400Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON401Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON403Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON404Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarIcsJSON500Response is a constructor method for a GetTripsTripIDCalendarIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarIcsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarSubscriptionJSON200Response is a constructor method for a GetTripsTripIDCalendarSubscription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarSubscriptionJSON200Response(body CalendarSubscription) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarSubscriptionJSON400Response is a constructor method for a GetTripsTripIDCalendarSubscription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarSubscriptionJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarSubscriptionJSON401Response is a constructor method for a GetTripsTripIDCalendarSubscription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarSubscriptionJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarSubscriptionJSON403Response is a constructor method for a GetTripsTripIDCalendarSubscription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarSubscriptionJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarSubscriptionJSON404Response is a constructor method for a GetTripsTripIDCalendarSubscription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarSubscriptionJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCalendarSubscriptionJSON500Response is a constructor method for a GetTripsTripIDCalendarSubscription response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCalendarSubscriptionJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON401Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON403Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON404Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON409Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON500Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON204Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON400Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON401Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON403Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON404Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON409Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDConfirmJSON500Response is a constructor method for a PostTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDConfirmJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON401Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON403Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON404Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON500Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON404Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON500Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON401Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON403Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON404Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON409Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON500Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON400Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON401Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON403Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON404Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON500Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON200Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON200Response(body GetLinksResponseArray) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON400Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON404Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON500Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON200Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON200Response(body GetLinksResponseArray) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON401Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON404Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON412Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON412Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        412,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON428Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON428Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        428,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLinksLinkIDJSON500Response is a constructor method for a PatchTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLinksLinkIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON401Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON403Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON404Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON500Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Apply an iTIP reply to a trip invitation.
+	// (POST /calendar/replies)
+	PostCalendarReplies(w http.ResponseWriter, r *http.Request) *Response
+	// Remove a participant from a trip.
+	// (DELETE /participants/{participantId})
+	DeleteParticipantsParticipantID(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Asks a participant to confirm from the link of their invitation.
+	// (GET /participants/{participantId}/confirm)
+	GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Confirms a participant from the page of their invitation link.
+	// (POST /participants/{participantId}/confirm)
+	PostParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Returns the profile of a participant.
+	// (GET /participants/{participantId}/profile)
+	GetParticipantsParticipantIDProfile(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Updates the profile of a participant.
+	// (PUT /participants/{participantId}/profile)
+	PutParticipantsParticipantIDProfile(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Changes the role of a participant on a trip.
+	// (PATCH /participants/{participantId}/role)
+	PatchParticipantsParticipantIDRole(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Changes the RSVP of a participant.
+	// (PUT /participants/{participantId}/rsvp)
+	PutParticipantsParticipantIDRsvp(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request) *Response
+	// Delete a trip with its participants, activities and links.
+	// (DELETE /trips/{tripId})
+	DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDActivitiesParams) *Response
+	// Create several trip activities at once.
+	// (POST /trips/{tripId}/activities/bulk)
+	PostTripsTripIDActivitiesBulk(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDActivitiesBulkParams) *Response
+	// Import trip activities from an iCalendar file.
+	// (POST /trips/{tripId}/activities/import)
+	PostTripsTripIDActivitiesImport(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDActivitiesImportParams) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Get a trip activity.
+	// (GET /trips/{tripId}/activities/{activityId})
+	GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Update a trip activity.
+	// (PATCH /trips/{tripId}/activities/{activityId})
+	PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, params PatchTripsTripIDActivitiesActivityIDParams) *Response
+	// Cancel a single occurrence of a recurring activity.
+	// (DELETE /trips/{tripId}/activities/{activityId}/occurrences/{date})
+	DeleteTripsTripIDActivitiesActivityIDOccurrencesDate(w http.ResponseWriter, r *http.Request, tripID string, activityID string, date openapi_types.Date) *Response
+	// Modify a single occurrence of a recurring activity.
+	// (PUT /trips/{tripId}/activities/{activityId}/occurrences/{date})
+	PutTripsTripIDActivitiesActivityIDOccurrencesDate(w http.ResponseWriter, r *http.Request, tripID string, activityID string, date openapi_types.Date) *Response
+	// Export a trip itinerary as iCalendar.
+	// (GET /trips/{tripId}/calendar.ics)
+	GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the calendar subscription URL of a trip.
+	// (GET /trips/{tripId}/calendar/subscription)
+	GetTripsTripIDCalendarSubscription(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a trip from the page of its confirmation link.
+	// (POST /trips/{tripId}/confirm)
+	PostTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip link.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Get a trip link.
+	// (GET /trips/{tripId}/links/{linkId})
+	GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Update a trip link.
+	// (PATCH /trips/{tripId}/links/{linkId})
+	PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string, params PatchTripsTripIDLinksLinkIDParams) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParticipantsParams) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	Middlewares      Middlewares
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// PostCalendarReplies operation middleware
+func (siw *ServerInterfaceWrapper) PostCalendarReplies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, InboundSecretScopes, []string{""})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostCalendarReplies(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Inbound(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteParticipantsParticipantID(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDProfile(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutParticipantsParticipantIDProfile operation middleware
+func (siw *ServerInterfaceWrapper) PutParticipantsParticipantIDProfile(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutParticipantsParticipantIDProfile(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDRole operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDRole(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutParticipantsParticipantIDRsvp operation middleware
+func (siw *ServerInterfaceWrapper) PutParticipantsParticipantIDRsvp(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutParticipantsParticipantIDRsvp(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category); err != nil {
+		err = fmt.Errorf("invalid format for parameter category: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "category"})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From); err != nil {
+		err = fmt.Errorf("invalid format for parameter from: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "from"})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To); err != nil {
+		err = fmt.Errorf("invalid format for parameter to: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "to"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "strict" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "strict", r.URL.Query(), &params.Strict); err != nil {
+		err = fmt.Errorf("invalid format for parameter strict: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "strict"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesBulk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesBulkParams
+
+	// ------------- Optional query parameter "strict" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "strict", r.URL.Query(), &params.Strict); err != nil {
+		err = fmt.Errorf("invalid format for parameter strict: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "strict"})
+		return
+	}
+
+	// ------------- Optional query parameter "atomic" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "atomic", r.URL.Query(), &params.Atomic); err != nil {
+		err = fmt.Errorf("invalid format for parameter atomic: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "atomic"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesBulk(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDActivitiesImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun); err != nil {
+		err = fmt.Errorf("invalid format for parameter dry_run: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "dry_run"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesImport(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDActivitiesActivityIDParams
+
+	// ------------- Optional query parameter "strict" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "strict", r.URL.Query(), &params.Strict); err != nil {
+		err = fmt.Errorf("invalid format for parameter strict: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "strict"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityID(w, r, tripID, activityID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOccurrencesDate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityIDOccurrencesDate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
 	}
-}
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
 	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityIDOccurrencesDate(w, r, tripID, activityID, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// PutTripsTripIDActivitiesActivityIDOccurrencesDate operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityIDOccurrencesDate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityIDOccurrencesDate(w, r, tripID, activityID, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDCalendarIcs operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCalendarIcs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
 		return
 	}
 
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant", "calendar"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.GetTripsTripIDCalendarIcs(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -516,15 +3952,28 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDCalendarSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCalendarSubscription(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.GetTripsTripIDCalendarSubscription(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -534,11 +3983,14 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -549,8 +4001,10 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -560,11 +4014,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -575,8 +4032,10 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 		return
 	}
 
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDConfirm(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -586,11 +4045,14 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -601,8 +4063,10 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		return
 	}
 
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -612,11 +4076,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -628,7 +4095,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -641,8 +4108,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -653,8 +4120,10 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		return
 	}
 
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -664,11 +4133,14 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -679,8 +4151,18 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner"})
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -690,11 +4172,14 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -705,8 +4190,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDLinksLinkID(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -719,8 +4212,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PatchTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -731,8 +4224,41 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDLinksLinkIDParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{n, "If-Match"})
+			return
+		}
+
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "If-Match"})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PatchTripsTripIDLinksLinkID(w, r, tripID, linkID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -742,6 +4268,9 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
@@ -757,8 +4286,21 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		return
 	}
 
+	ctx = context.WithValue(ctx, MagicLinkScopes, []string{"owner", "participant"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParticipantsParams
+
+	// ------------- Optional query parameter "rsvp" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "rsvp", r.URL.Query(), &params.Rsvp); err != nil {
+		err = fmt.Errorf("invalid format for parameter rsvp: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "rsvp"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -768,6 +4310,9 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		}
 	})
 
+	// Operation specific middleware
+	handler = siw.Middlewares.Auth(handler).ServeHTTP
+
 	handler(w, r.WithContext(ctx))
 }
 
@@ -857,9 +4402,16 @@ func (err RequiredHeaderError) ParamName() string        { return err.paramName
 func (err InvalidParamFormatError) ParamName() string    { return err.paramName }
 func (err TooManyValuesForParamError) ParamName() string { return err.paramName }
 
+// Middlewares holds the set of middleware for this service
+type Middlewares struct {
+	Auth    func(http.Handler) http.Handler
+	Inbound func(http.Handler) http.Handler
+}
+
 type ServerOptions struct {
 	BaseURL          string
 	BaseRouter       chi.Router
+	Middlewares      Middlewares
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
@@ -868,8 +4420,9 @@ type ServerOption func(*ServerOptions)
 // Handler creates http.Handler with routing matching OpenAPI spec.
 func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	options := &ServerOptions{
-		BaseURL:    "/",
-		BaseRouter: chi.NewRouter(),
+		BaseURL:     "/",
+		BaseRouter:  chi.NewRouter(),
+		Middlewares: Middlewares{},
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 		},
@@ -882,20 +4435,51 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	r := options.BaseRouter
 	wrapper := ServerInterfaceWrapper{
 		Handler:          si,
+		Middlewares:      options.Middlewares,
 		ErrorHandlerFunc: options.ErrorHandlerFunc,
 	}
 
+	// Operation specific middleware
+	if options.Middlewares.Auth == nil {
+		panic("goapi-gen: could not find tagged middleware auth (Auth)")
+	}
+	if options.Middlewares.Inbound == nil {
+		panic("goapi-gen: could not find tagged middleware inbound (Inbound)")
+	}
+
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Post("/calendar/replies", wrapper.PostCalendarReplies)
+		r.Delete("/participants/{participantId}", wrapper.DeleteParticipantsParticipantID)
+		r.Get("/participants/{participantId}/confirm", wrapper.GetParticipantsParticipantIDConfirm)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Post("/participants/{participantId}/confirm", wrapper.PostParticipantsParticipantIDConfirm)
+		r.Get("/participants/{participantId}/profile", wrapper.GetParticipantsParticipantIDProfile)
+		r.Put("/participants/{participantId}/profile", wrapper.PutParticipantsParticipantIDProfile)
+		r.Patch("/participants/{participantId}/role", wrapper.PatchParticipantsParticipantIDRole)
+		r.Put("/participants/{participantId}/rsvp", wrapper.PutParticipantsParticipantIDRsvp)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Delete("/trips/{tripId}", wrapper.DeleteTripsTripID)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Post("/trips/{tripId}/activities/bulk", wrapper.PostTripsTripIDActivitiesBulk)
+		r.Post("/trips/{tripId}/activities/import", wrapper.PostTripsTripIDActivitiesImport)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Get("/trips/{tripId}/activities/{activityId}", wrapper.GetTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}", wrapper.PatchTripsTripIDActivitiesActivityID)
+		r.Delete("/trips/{tripId}/activities/{activityId}/occurrences/{date}", wrapper.DeleteTripsTripIDActivitiesActivityIDOccurrencesDate)
+		r.Put("/trips/{tripId}/activities/{activityId}/occurrences/{date}", wrapper.PutTripsTripIDActivitiesActivityIDOccurrencesDate)
+		r.Get("/trips/{tripId}/calendar.ics", wrapper.GetTripsTripIDCalendarIcs)
+		r.Get("/trips/{tripId}/calendar/subscription", wrapper.GetTripsTripIDCalendarSubscription)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Post("/trips/{tripId}/confirm", wrapper.PostTripsTripIDConfirm)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Get("/trips/{tripId}/links/{linkId}", wrapper.GetTripsTripIDLinksLinkID)
+		r.Patch("/trips/{tripId}/links/{linkId}", wrapper.PatchTripsTripIDLinksLinkID)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
 	})
 	return r
@@ -913,6 +4497,24 @@ func WithServerBaseURL(url string) ServerOption {
 	}
 }
 
+func WithAuthMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Auth = middleware
+	}
+}
+
+func WithInboundMiddleware(middleware func(http.Handler) http.Handler) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares.Inbound = middleware
+	}
+}
+
+func WithMiddlewares(middlewares Middlewares) ServerOption {
+	return func(s *ServerOptions) {
+		s.Middlewares = middlewares
+	}
+}
+
 func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err error)) ServerOption {
 	return func(s *ServerOptions) {
 		s.ErrorHandlerFunc = handler
@@ -922,30 +4524,103 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Raz27bOBN/FYLfd5TjdDcnAXtom6LwItgGRRd7KIqAlsY2E4lUyZFTw/DT7GFPe9wn",
-	"yIstSMo2Jcs2rcSbOr20tiJy/vxmfjNDek4TmRdSgEBN4znVyQRyZj++VcAQXifIpxxnH+FrCRrNH1ia",
-	"cuRSsOxayQIUctA0HrFMQ0QL79GcyiQplb5hdt1Iqtx8oilD6CHPgUYUZwXQmGpUXIxpRL/1xrIH31Cx",
-	"HrKx3WTKMm6W0Jgq+FpyBSldLCKKHDMwL3TeYxGtv8WfPW2Xm39ZKSiHt5AgXUQbftGFFBoOdAyrlg/S",
-	"mmfKkqcbTmmq6a3drt8VF3fdMHu8WyNaqqxul+KdsY7MZhtYOS2dpH1e6IRQxsVdF3Sqddt1+qR40Q2Z",
-	"FDRywczb5mvOxRWIMU5ofNHZuTkXv1xYIyBnPNM3KG+4mHK0/uIIua75wL616YTVA6YUm4WLT/kUIren",
-	"1UGkx2ILeS9A3ThR+w0KNmCtuxMgWP7Y5NHIFB7HDY1Y9QPKl7sGoiUsapbW/bov6DslIipedEnEal2b",
-	"Tu+UkmqvGinoRPHCpRt9w1KiqrRtqpiD1mzcgntTp+WLbUq9BzR0pR/BV7qWs/9XMKIx/V9/XeL7VX3v",
-	"N4W9tmnbTOM2btNByrv9DrOAh4C8tewHVp2mSU7GnmLyHtAEcFXzOejHVf2lvYFAtYv+UCKoMNg8sQdZ",
-	"NxBiKeIoSB7aHe4AfxeqazEHWe85+PlQ9iDYQDmijuDDfNekfmapPCw0LgFNEXgEgQc6oCHIPPowvG2l",
-	"9gP0XW5ztG7r4M5lEYXmCNc3iRQjrnJIvbgfSpkBE7RDu9CaKyGdQE2VHd6/Zgp5wgsmsGvIFN4WhyZR",
-	"m/gwnqxJPdDALkQR2oyuoqVDdCz7UVFmGRsa7kRVQlBMVA3eUqe98A9sf+g5p9uUc7QWvWHj9pb19yL9",
-	"nue0481I39PksQmM2YOLkaxc7PXm73QBCR/xhD389fAPaJIy8vp6QAqmGJFkyJK7HojUPGZF5l77U5Ii",
-	"Y0KcgSKJFBpV+fB3ykhaKiYQiCS/Xf1BfpWlEjAzKz/K5A5QA8OzVXMR0+UeNKJTUNrp8+rs/OzcdjgF",
-	"CFZwGtOf7aOIFgwn1k19n236c+/bIF30q0xzXIjJxB6mFaCsx8w0RK/NY5+JvM+Dy7fVeiNQsRwQlKbx",
-	"5znlRj+jxDLBY1oTTX2cHFU4fg0ZwL6YxY4PrY0/nV+Y/xIpEITLosL631jRv9UuP9b7gyhzEx2GrEwA",
-	"1EnLBkAd+EsYsTJDsiozi4henJ8fJHRXSXGDYotgfxq0mVPmOVMzGtPK85ow4jmWSEEYMX2LDR6bKs2C",
-	"Y/bpm1dcCZSOdxqoS21LkK5wAo1vZDp7MoM3j6gaqWuB2ID51VEUWGJ6GrhbxQkjAu4t0B7ODlQP4P7c",
-	"nU4sjCJjaAG6ajW0+WdwGZTH1YHH0ybw0/l0yyxxGui+B6zyl6TOgLMWfCNalG1JWz4blk/PEJvNURBD",
-	"/HiFwDmqhfW3s0G/fnRQEUNd4KcJ10TJEoHc8ywjCrBUgrAsIzgBYmRqMgS8BxD2iQ3aVYdFmEhJ1WO5",
-	"lyMCU/uq1GZLnMgSyVoRo/kualqfWbwgkmo56Ts5nqpDuAw+/8DH8NXuLuNZIT5Wd9O80n6WDmfj/vjE",
-	"uhw/xGZbA6yF4rzJJqDxOWSOOQq1/LADzApjkRJthmfo5YxnxN4CWlV0YFFz94YhQ43DfFC9f9pcs/U0",
-	"7Ah08xLCzvmLaJmDFEBQrpqXkIl5HW2re9AAdrFXli+kbanfHZ9ct2Jh85Gu7ppDe5T/HspjtSf+L7ee",
-	"pTWp/WjqFNsSEzptodTCFs2LpgDS8M9cX9DI03prd3I04uO5q24sFv8GAAD//6I5Fyf6KgAA",
+	"H4sIAAAAAAAC/+x9z3LjOJL3qyD4fRFzoWW7xjXb5Yg+1L/p9UxNl8N2be9GR4UDIlMS2hTAAUC71BV+",
+	"mj3MaY/7BP1iGwmAJEhREklJtsvFPnRJMgkkEolfJjITia9BJOap4MC1Ck6/BiqawZyaj68jzW6ZXryl",
+	"GqZCLvA34Nk8OP010JJylQqpgzBIRDxlfBqEwUSIOAgDxaYzrQDsj0LPQAafw0AvUghOA6Ul/uE+LDsQ",
+	"SmPjNI6ZZoLT5FyKFKRmoILTCU0UhEHq/fQ1oHORcfNSDCqSLMX3gtPgUgsJMbljekZekBgiNqcJSRMa",
+	"gQpJlhItyKviv9GrVyNDtpxTHZwGscjGCQRhMKdf2BxHWnk2DOaM29+PiuHwbD4GGYTBl4OpOIAvWtID",
+	"TaeGyluasJhqfGyq4cejMNHwY6XJ4P4+DKJMSuDRYnk4Z5cfycmL438j+SMkEjGEBEbTEXlz8WEUFHQ4",
+	"tm6iQ8I/MyYhDpkS2HJwjxTkv+LUOtZ6ZJVzJ8a/QaT9ubsA9xhsnMHq0C4gBaoV0TMg1DVGqDbfFZ0D",
+	"SUREE6LZHEKi7LQKHgGhPCbwJaU8dhPNuHlJS5YSHKUaBXVxmeAAcxbnMhxTliyCMLgDuEkWjRLKuAZ5",
+	"S5PlmcnJh1uQC5I/R2K6UCERkmCjitzNgBPb/oi8gwnNEhyyIMejqpR5onUcBjxLEoqSeKplBgVd2Mu0",
+	"hayJOdOcJeGc8R+Pwzn98qMTtIxr1jCWD1RppDwkjFfnA7lPfhccQkI5EVE+1ySinMxomgInglfXENKw",
+	"cggla5EryK1lct7RhSJiYijBpwh1LCzpsl0r7LvCVnwlpgt82xCrrqlG6piGuenp/0uYBKfB/zssUe/Q",
+	"Qd7hL5ai4L6glkqJ32sLpBSmdQvjFyo5DrUjrrm3r1ls5DbnapaxOGjgIsKBL9PiFmRC00ZhnoNSdGoe",
+	"r/2tNkDTaPl8WKGqacxvaQI8pvIyG3sT2Wng8CVlEnC+KuNGaTpAKWwavBY3wJflJ6eGmL+TGSQxGS+M",
+	"bHxqgkxcGDKpsluyINzAJXwnpyH06W/kkASqwckGA3WBItRd6xXv47dWQl3peJF3e2/A58w28PLoyMCP",
+	"+3pcE//WGqWEm5dHRw16pSS+HYdUKriCrVhUx2xlgMKhnJAxSB/vGChyBxKIAt4eNizhcc7ijfDRhQ+L",
+	"nnISxxKU+TinXz4An+qZm+f1uNxasxSTHAZjIW4Yn15LmEBhCHjdvnj5cpfdvnj50nQbeVbpuulZsmLv",
+	"wyDOJEVWXs8Zz3STqFwC6nYe44ImEynmnj4hbyn/kyZjKyfW1nSPjoL9KXIzatfPSozcqHZBaTZHeb2O",
+	"nNXdinnCgkZCNdNZDNX+l63mI48NB69Wi11L4znnAxrRB6+cGW2lD63EXN3sX+gSwadtGHD8Q4UD5usO",
+	"WXD8g+XB8Q+WCYVsttSdbSHdYZdv4beRFm9PgGDI5nCNNmTD9ub1z699G9Nsa14rRg+vxM1CLNt2xbO5",
+	"faglS0fBrqYamzeU3hu6ddKIZT03XKUc1RRCOXt5p230wjbacXHWzrq8szZse2ujbvy21IVIj9fb6uF/",
+	"YPymn0osZnOLldDGSmwtDtjYkihYKm1Pm7jQSwASxm9aTX6NMPfeapquJEv7zUyMKomXIM54vtpO+q81",
+	"xn88sQpzTlmirrW4ZvyWaaiIcsED81TjFqOXJRyzWwhtm22U9hYiaZwkG4H5g30KNcUdB3ltSdvMgNYD",
+	"LsdqO+B0vu1iU5pKvS+27Uonfbp6uxftU1t9/hLxOVOKVoOgV+aiOvOblnEvaEFV3Ada3HuraSq3Vlv5",
+	"UqrT/HOWJNY9V3F43VFFuNAksj1XPFtuNJtNbCmF3LQk35uHHlLHXrONSvZ9TnkHP655h8hcZuqTUHdQ",
+	"MW4k/1o6XRHmKwG3YxPKEkAqM04zPROS/W6+ToQcszg2jhYu9PVEZBx/jwSfJCzSpleIBI9ZtZ3KrwVT",
+	"nF+X06TRTzZhkMTt5+Ov+HgxndWpWOd0s7MESjv57OiT815umkqPqm6rxoy+kdy1Q8mSFo5F27Z7umyw",
+	"if6fQKOZo7awc9pPYb2z1/nsrV1Yto82xNv2uo2gpQN4hV3b1qcZBrcglTO9at6J+ngtAZ6FWr68ggmo",
+	"Tfbq0fvIgQDXckFSkCTKHb8uBJBvEE1cw3j7QozXIOQLBcZxIzLtef9a+/xWju1jpkGuEJ8wEJlWLIZr",
+	"Sfm0wfQomyN6RjXhgqDDASSZ0CQhY9B3AF6wqzAEbEysdEFtN4YzzleOYbUvsz66TiLhddnb07lRLzc6",
+	"Kje+tWs/4waX4E58fLaBa7M5WBYz9+fVwb6leN4DeRdbQt56J+R6N1vNY7hxQBscfhs7K0Om19babwh2",
+	"5lClQNqlz5QfakWTdAocJPKVTIQMO8xcm0nr6j30X1klY8UDPaSswU7amwfyqu5QzOkMzbeap5FkPAGl",
+	"CNNkRvEfRcQdH3XTyz0Vru8lzJtYngh/sJXdYQUSGpDJWxdhgareWvOXQhOUdoJ7T0vuPQDaS82FQb5a",
+	"20hofbdu192GOJ+j6x1o3LdvseduyYBaR/jTx/FvjbvxDvTmzfSdxusoz+MqGM24/stJ0KQYcbvH5Bzi",
+	"65RKzSKWUq67NdHB2djZcddZ8xpI6YGHLfUkU9cFxzy0GQuRAOVGu+EmpRP/9utv3OA/vA+D3hPf1Z3o",
+	"v7JqOosHtp3QioJaDy5mqtv4Ayuzv8YX2MjTDWstXF7BVWGqqqE6I5fVkROrNbhz7lHREyz9gXRVH03d",
+	"t9siVXrtOMB+KjICpdiYJejz42L9tqOUwZiBprLLGx1WNWv2KLVAqAJv6imLfJrRaWGdwQF2rWxGhgsW",
+	"e5wPCS/8vUyTiUgScac6RJDvwyDHpI0PpjPB2z0pxWYs9eTiQlhQleo2NfPUrhN8Wmmqs43CfnH5H+eX",
+	"9sn8xSyNzX6uPXA2AZbDnVw+athk2JDzrS6JYaNEV4fls2SZ7rXwcjZPhdR7dVKVjedhBZMhbFw8dyLD",
+	"7ETI/4KahJJYLojMeGtXjh3Euhy0MIjl4lpmvHmZqRuWpnYNturw0j7//ha43oiAec9h1V2U97l6VvYY",
+	"+amzuXOQZxu30NYOnk4ujB7b+4o9srGDNS7oNpGNanwqW7npLU0b37YoWNEoRiYS6iNorwyFvYXLa5xY",
+	"HZz90E0NjoKwCLil+uDNhfneGOyqq5flbGpxIOSUcvY7SGXy/i20lgYvOp9tzJmkINIEQl/12ndoHPtZ",
+	"tviKsRdDcsvgLm9Z8GRBJNC4opnzkUTiuqCkaraiS8Q00zhET6tVA5FMQ+z0S2o/xhAljJuPc7oY2zjb",
+	"XNxC3NhyBQa7ydT6uCBVgvvEVl3r6L5JExZZP4OLqDYSuOXSrC5GR9b6uN0nIxxD+vKQvjykLw/py7tK",
+	"X968yXnEJOW/A6R2L2lb1CZsYPaaOGyXRvSQGcqeW/N4Z3JUnO4pkpg3gv9WOco7cuw/WH7VpuQqy5wd",
+	"ZDDvbXbXJDnvppci53kFcz4WUc+ehvouN1b7h+Md4N4ThQBvV3EuxYQl0PfAY6Mz0xvs8dFuzUJsz0zO",
+	"kj/0ITrtGl7JfZLrZ3/rAyutfZptBw3HfzlZ3v+a0XxuI1QXoq9E9XK51ug0baymE/eb/YjLfboPIWrd",
+	"PcI1LrgGVvPhyR4JeTrHMZ7sIYdHsmk7n3tokr68joPnP1EZj80BnrlwH3QGyn66g5jnn/Usk+7jRDL7",
+	"QVGdSfz4uSnUoXAbwfTiEufWSi/jY8xPv4RIQlOJGvM7UTNaVKpx2V+3LAJM8LqjMmZ8WglsSYiA3UJR",
+	"yYCm1gPEbPkNhi3PgMZmL2bVQvCfB2eWlANHSzEAmrK/g81Pp1MWoVHaQCibcohdFQXjK/DD3LmrkZxp",
+	"6+FLlECnQkqVgphQF10zL/8zA0zRpZLOQYM04Y/KAyj6xGSHmx8Tyg0DUjoFRVKhjGfCLB8TuAAqQZaj",
+	"mWmdWuFhfCKWB/JepRCxCYvoH//6439BkZiS1+dnhiAiyJhGNwfAY/yZGq7+8a8//ltg1SDORyBJJLjS",
+	"Mvvjf2JK0PfCNRBBfv7wC/mbyCSHBb55IaIb0AqsF8XZZUHehpe0dRocj45GR8Y1nwKnKQtOgz+bn8Ig",
+	"pXpmhOgwT2Y+lJAmeQTb+Tyqw7Noa9mJeJ07gz0HqQ02Ua6wvIHlsfF/Gvnx/EDLs3uFjxYVNS7en3/4",
+	"L8LsnNhZrgvmCjl2o0DeINKbjvG0UHAulM7bv3BDLU43vBHxwp4i4dr5WDV80QVzyopVTc7MCpogQpkf",
+	"7CbR8PPF0UmtdW9ZHf7mnLBlBzmcIO4hHFTxz3RYy+60Z8VIsRW+D4OTo6NOnbY4RbTc8RsaE1kW+zg5",
+	"Ot5/n5/8Qzum0z/vv9O/FkeDTI8n++/xZ6GJPYB0HwYvH2Iyz9xpJbO2QBJwD5baJzj9dUnv/Pr5/nMY",
+	"qGw+p3KBYeg0xRJKnLCrs3OzHheYKUHtgi/hwMCX0dbLyStGoc9ZHCdwRyUoG0gx3QafkaBD/43Dr963",
+	"s/jeQlcC1sitwsA787ufBON9Pntn4z1WfSg32uDUwGWp7yq9BfXFH3rTsOmE4ucBKAageLZA4dl8v9pc",
+	"wKCGFRcm6EloxYYwcSRahGW7YATOdwuAOHRZQciAaZPt/DEFNEkNJcZQZPymGvu2QJZbHV7zI3IBPMZI",
+	"MzV2pTV4KBlnWgtOXM/uRWxdimw6I+cfL69CooTtKpWAgWYbwE4xZKijmYte/0nnjZgWli2dn0CvxLe3",
+	"buCPDXNHTdbWTM+TjZZWHzzr2/rTBK2+o/GQae1K9ea9vl5fqxtVW61aFOLYtFyY3ELlu+VstivRbFmZ",
+	"n+PPT17WB5U+qPRtVPrJ0av99/g2L3HwlG2INcjkFnsdnQTfzpIIV/hCLoHr3BPhNHvhDZkC+en9FWll",
+	"gRBpjYURKQZgzIKE3QA5f3319t9btoOGgqwaHpbicW6jiExHYg7NnpHBYBgMhq6417fZDeDWt9n1CNa3",
+	"1YeBqcJySssk24rlZEyqfe2GUhs6X7kburDr2HekGxKprm58rtwAiqraNfpJKhjXZAYSuu1aXGz/yYFQ",
+	"/9W8+bjXYOgNvptvwO66AJ1JbqHB4Qiu/gq89be9Mt2w6cueJFCsiiP1n8JNKU5D4GnApAGTGjDJD1Tv",
+	"GJM22lJ53lcvj9WFeLbY5WfSDcA1ANcQCKvuC2eUTx1kSdGAV1t7sjYjl7o1NX6c0VUd6PnSQUtzmDEk",
+	"7iSjybQyaT/EHGgs0nyYxNJVdtc4Ih+rJzxlU/hPyPyUp/GE0Sll3O4tcfTEsA9Tg7jQbMJsQpCLyYmJ",
+	"uycrMuxscHWtsR0vcPzPCHz99OABcQfE/d4RN1zrl/PwN89t3JW9iLBVSatcdr9fmUf2gwbL9ya0QoPj",
+	"vRCQL/2nCw+PLcWlSBq2EUo43BnV50mgFSlPvA6/2vL2LXLfjKzh/1pmu9mGh5j4oGsGXdPTurcLL0+B",
+	"NaYx06pSvSRsLFsyWl7zG1ymLoCzFFt5Eqt+5/GTelXTJw0F39saKcT/J9C57Md2whrkeo27/7Fkd187",
+	"s8622KAtB205ZJA90FbQrtEGZ1sbBbxskB9WSzY2JlhcYSl+KTKNfrMkIdIEVQlexmFvGsdoRrtrOezD",
+	"Le4fWWchlLVOHgpvw+UbV5JFwYfSMmLcq5WvgLjyVm5MhjZzCLQkzj2xCHxyigIt6y/i20AXXiufp+4w",
+	"ZThPBA9XV2luog/fDxpZ5eq7d2KVISlLbWlaR1NHgrToRM4DWHkNVVMHQ++JG3pVsMkhtPy1klu7wiH2",
+	"ZMDoAvDMv49DJvlN3IJMaEqENmdz3EGfk6NXhHGlgcaIU3ZhYhJuXrVp1cLD/iNdWXz1Crr7sktX3N7/",
+	"GH7Cpapeg8E6GKyDwboudpE7in3gXayC3f7m6+E4S25axDHqsP0GX3va0L03vA4bKqaicWbKEeEHW4/d",
+	"mGemZI1PqbHhbS0cSybeN7qKGqrFnEUVamILk7WqOQ+rTIzR9vjq5JuxHQeFsh+F8uLFowjWJ55KEYFS",
+	"6IAjwLW7NeEZ6BuFqTY0qZv7BNGVR7AH/cPMbQ09NJC95uERdZDZm3vX/hV6aOlejtxZY77nVQJWYX55",
+	"48WmLcOeNugrbzVpibG7xPttaRnwfogPPwl8tYK8hKu2LAr3qqRhYvseYPar+7zomk5Srr3XeQvvHhB0",
+	"GxouRzLkrwz4NODTTvJXtvIwtMpQ+Y6A5AGCJf49BS2gJnT1XQ2B76/otOGuJler15UazY97S1AikxGE",
+	"6NhQwGNT8hSjT2eTg39QHc1GTaZqefh+iNg8fsRmsS5es+ZQ2/ewfJd2drg8cunPF8MYcNsGMcPi1cTV",
+	"TohDQnORK/Z4TJMpaKwFcfLih5XVlfO1s3bpfH8hq+YLwFr5GI/2RkSHHef3CLODMfk8omUnxy/23+G5",
+	"hEhwe5eDiXxA/NiL5sUPDzzs8p6FZ5RTt88Qpe+7OBTFNU/q8CsSsL07o7w5Sr2z+VDP0Kp5Rxf5silZ",
+	"mCeR5Z02JJJVqXP5Yi3oap1ZNnhdBkU5pJU8VJiP8ggSQolifJpUoMAcjrVXYeIuYUuHUItjHwMUPwEo",
+	"3tcebvk2xuFQzKAMBmXwlJTBP0TMJou9K4MG4z6/gmnEIrWmQqmtfFyccsATMQxda15eimqInpLiK01T",
+	"RVQ2xmbHYA9QAPl08cG5w2yhGVPYeQWFh+5tQ1RI7szhm/yPrm5qRDkR9rwGje0RjWKAGw7l5JSeRerp",
+	"nODtdkvWgLBDkPOpAl4YFGJcw773X0w+Rn6BlWYcJJULBBT21lu8W54TbISRNYhX1l1FkIpW4pi5OXEm",
+	"khgDDVU4QpBi0YwojcfGbP7xREhCyQKwKR4vAZbD17yd0I8bWJs0AqX8KtEGAxNojW+X/vCfQamCxnEN",
+	"cDjA4bdk/2Gc2C1lix8+SBkAMmbgjo5M7+B6LnPXqDPizPhG5I0Ud8rcj6Fu0FJFpCuuBcBIbJsbu9wh",
+	"2m1u7WJ6RD7qGUgSJQxMQcXiL0Sy6UwTekcXG/Gyw80cD2cL9rhi4Rx57ubEu00qZ3ZoJmrsJs9mDQ97",
+	"/wGKh73//qrRuiVIy820CZn6Vx8aynsUqOp/n1KzkihuPfJRonqdkh2FuVIJG4oF2KeFD8KhGea4rY5o",
+	"vlypbg3/7fLjz6SAzearl54tpDezaIDzAc4HOH9MOF+6bIqVJmjzZVM9zXijJ0C1PrB35p7/tmu82VH4",
+	"Vx/s7/zzAIUDFD6jg2+27L8ScxC8CMFseedBDZNMWVXPsbBuh/3BPPs8qqWasQzls76VwxhL1X/ND+2L",
+	"Zj286O6rjgiO5FEriFgChrPkw+5i2F10LUZV30g4FOultA+/4j9dz6QbIMT/PfYRNEv8cAx9gLZht7CT",
+	"Y+h9sCVsbfY/W8jY365iOGI+7Go272pWbGranSx/Tkvz6R4m32u2f+f93ABYg8n1nHaTw9np4ex0/7PT",
+	"O9pRV5zoq9LszmeCQ0hiBhrznbkwN4vYDF82ZgnTxY/mzkz8bO8uExFNgFAJee6wO0GhBRHlFcD4qHPy",
+	"e/Tg9wUZQyJMPpjNy8CmcLtqkgfMHb8YIYBEwaY0Of++3ydxm4nP+qX7TAwflaZ69YUm0t5T3OMykweo",
+	"PuVze3CaDp6FbzbZ2cGtv1j7BiHv7/9vACFAyHH96gAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSignerVerify(t *testing.T) {
+	signer := NewSigner([]byte("secret"), time.Hour)
+	tripID := uuid.New()
+	participantID := uuid.New()
+
+	issue := func(t *testing.T, c Claims) string {
+		t.Helper()
+		token, err := signer.Issue(c)
+		if err != nil {
+			t.Fatalf("Issue: %v", err)
+		}
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		token   func(t *testing.T) string
+		want    Claims
+		wantErr error
+	}{
+		{
+			name: "owner",
+			token: func(t *testing.T) string {
+				return issue(t, Claims{TripID: tripID, Role: RoleOwner})
+			},
+			want: Claims{TripID: tripID, Role: RoleOwner},
+		},
+		{
+			name: "participant",
+			token: func(t *testing.T) string {
+				return issue(t, Claims{TripID: tripID, ParticipantID: participantID, Role: RoleParticipant})
+			},
+			want: Claims{TripID: tripID, ParticipantID: participantID, Role: RoleParticipant},
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T) string {
+				return issue(t, Claims{TripID: tripID, Role: RoleOwner, ExpiresAt: time.Now().Add(-time.Minute)})
+			},
+			wantErr: ErrExpiredToken,
+		},
+		{
+			name: "signed with another secret",
+			token: func(t *testing.T) string {
+				token, err := NewSigner([]byte("other"), time.Hour).Owner(tripID)
+				if err != nil {
+					t.Fatalf("Owner: %v", err)
+				}
+				return token
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "tampered claims",
+			token: func(t *testing.T) string {
+				_, sig, _ := strings.Cut(issue(t, Claims{TripID: tripID, ParticipantID: participantID, Role: RoleParticipant}), ".")
+				forged := `{"trip_id":"` + tripID.String() + `","role":"owner","expires_at":"2999-01-01T00:00:00Z"}`
+				return base64.RawURLEncoding.EncodeToString([]byte(forged)) + "." + sig
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "without signature",
+			token:   func(t *testing.T) string { return "e30" },
+			wantErr: ErrMalformedToken,
+		},
+		{
+			name:    "not base64",
+			token:   func(t *testing.T) string { return "not base64!.c2ln" },
+			wantErr: ErrMalformedToken,
+		},
+		{
+			name: "signed payload that is not JSON",
+			token: func(t *testing.T) string {
+				payload := []byte("not json")
+				enc := base64.RawURLEncoding
+				return enc.EncodeToString(payload) + "." + enc.EncodeToString(signer.sign(payload))
+			},
+			wantErr: ErrMalformedToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := signer.Verify(tt.token(t))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.TripID != tt.want.TripID || got.ParticipantID != tt.want.ParticipantID || got.Role != tt.want.Role {
+				t.Errorf("Verify = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignerIssueExpiry(t *testing.T) {
+	signer := NewSigner([]byte("secret"), time.Hour)
+	expiresAt := time.Now().Add(CalendarTTL).Truncate(time.Second).UTC()
+
+	tests := []struct {
+		name   string
+		claims Claims
+		from   time.Time
+		until  time.Time
+	}{
+		{
+			name:   "defaults to the signer TTL",
+			claims: Claims{TripID: uuid.New(), Role: RoleOwner},
+			from:   time.Now().Add(time.Hour - time.Minute),
+			until:  time.Now().Add(time.Hour + time.Minute),
+		},
+		{
+			name:   "keeps the given expiry",
+			claims: Claims{TripID: uuid.New(), Role: RoleCalendar, ExpiresAt: expiresAt},
+			from:   expiresAt,
+			until:  expiresAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := signer.Issue(tt.claims)
+			if err != nil {
+				t.Fatalf("Issue: %v", err)
+			}
+
+			got, err := signer.Verify(token)
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if got.ExpiresAt.Before(tt.from) || got.ExpiresAt.After(tt.until) {
+				t.Errorf("ExpiresAt = %v, want between %v and %v", got.ExpiresAt, tt.from, tt.until)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoCalendar is returned by Decode when the input holds no VCALENDAR.
+var ErrNoCalendar = errors.New("ical: no VCALENDAR found")
+
+// Decode reads a calendar in the iCalendar format from r. Only the events
+// and the properties Encode writes are read, with DURATION taken as the
+// end of events without DTEND. Times with a TZID are read in that IANA time
+// zone or, for other names such as the Windows ones, with the offsets of
+// the VTIMEZONE defining it. Floating times are read in floating, or in UTC
+// when it is nil. Events with a property that can't be read are listed in
+// Invalid instead of Events.
+func Decode(r io.Reader, floating *time.Location) (Calendar, error) {
+	var (
+		cal      Calendar
+		found    bool
+		event    *Event
+		eventErr error         // of the first property of the current event that can't be read
+		duration time.Duration // of the current event, applied at its end
+		skipped  []string      // components ignored, nested in the current one
+	)
+
+	lines, err := unfold(r)
+	if err != nil {
+		return cal, err
+	}
+
+	d := decoder{floating: floating}
+	if d.floating == nil {
+		d.floating = time.UTC
+	}
+	if d.zones, err = parseTimeZones(lines); err != nil {
+		return cal, err
+	}
+
+	for n, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		name, params, value, err := parseLine(line)
+		if err != nil {
+			return cal, fmt.Errorf("ical: line %d: %w", n+1, err)
+		}
+
+		switch {
+		case name == "BEGIN" && len(skipped) > 0:
+			skipped = append(skipped, value)
+		case name == "END" && len(skipped) > 0:
+			skipped = skipped[:len(skipped)-1]
+		case len(skipped) > 0:
+		case name == "BEGIN" && value == "VCALENDAR":
+			found = true
+		case name == "BEGIN" && value == "VEVENT" && event == nil:
+			event, eventErr, duration = &Event{}, nil, 0
+		case name == "BEGIN":
+			skipped = append(skipped, value)
+		case name == "END" && value == "VEVENT" && event != nil:
+			if eventErr != nil {
+				cal.Invalid = append(cal.Invalid, InvalidEvent{UID: event.UID, Summary: event.Summary, Err: eventErr})
+				event = nil
+				continue
+			}
+			if event.End.IsZero() && duration > 0 {
+				event.End = event.Start.Add(duration)
+			}
+			cal.Events = append(cal.Events, *event)
+			event = nil
+		case name == "END":
+		case event != nil && name == "DURATION":
+			var err error
+			if duration, err = parseDuration(value); err != nil && eventErr == nil {
+				eventErr = fmt.Errorf("ical: line %d: %s: %w", n+1, name, err)
+			}
+		case event != nil:
+			if err := event.set(d, name, params, value); err != nil && eventErr == nil {
+				eventErr = fmt.Errorf("ical: line %d: %s: %w", n+1, name, err)
+			}
+		case name == "METHOD":
+			cal.Method = strings.ToUpper(value)
+		case name == "X-WR-CALNAME":
+			cal.Name = unescape(value)
+		}
+	}
+
+	if !found {
+		return cal, ErrNoCalendar
+	}
+	return cal, nil
+}
+
+// unfold reads the content lines of r, joining folded ones.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ical: failed to read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseLine splits a content line in its upper-cased name, parameters and
+// value. Parameter values may be quoted to hold ; and : characters.
+func parseLine(line string) (string, map[string]string, string, error) {
+	params := make(map[string]string)
+
+	end := strings.IndexAny(line, ";:")
+	if end <= 0 {
+		return "", nil, "", errors.New("missing property name")
+	}
+	name := strings.ToUpper(line[:end])
+
+	rest := line[end:]
+	for strings.HasPrefix(rest, ";") {
+		rest = rest[1:]
+
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return "", nil, "", errors.New("malformed parameter")
+		}
+		key := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var val string
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+			if closing < 0 {
+				return "", nil, "", errors.New("unterminated quoted parameter")
+			}
+			val, rest = rest[1:closing+1], rest[closing+2:]
+		} else {
+			stop := strings.IndexAny(rest, ";:")
+			if stop < 0 {
+				return "", nil, "", errors.New("missing value")
+			}
+			val, rest = rest[:stop], rest[stop:]
+		}
+		params[key] = val
+	}
+
+	if !strings.HasPrefix(rest, ":") {
+		return "", nil, "", errors.New("missing value")
+	}
+	return name, params, rest[1:], nil
+}
+
+// decoder holds what times are read with.
+type decoder struct {
+	zones    map[string]*timeZone
+	floating *time.Location
+}
+
+func (ev *Event) set(d decoder, name string, params map[string]string, value string) error {
+	var err error
+
+	switch name {
+	case "UID":
+		ev.UID = value
+	case "SEQUENCE":
+		ev.Sequence, err = strconv.Atoi(value)
+	case "DTSTAMP":
+		ev.Stamp, err = d.parseTime(value, params)
+	case "DTSTART":
+		ev.Start, err = d.parseTime(value, params)
+		ev.AllDay = isDate(value, params)
+	case "DTEND":
+		ev.End, err = d.parseTime(value, params)
+	case "SUMMARY":
+		ev.Summary = unescape(value)
+	case "DESCRIPTION":
+		ev.Description = unescape(value)
+	case "LOCATION":
+		ev.Location = unescape(value)
+	case "STATUS":
+		ev.Status = strings.ToUpper(value)
+	case "GEO":
+		lat, lon, ok := strings.Cut(value, ";")
+		if !ok {
+			return errors.New("expected latitude;longitude")
+		}
+		var geo [2]float64
+		if geo[0], err = strconv.ParseFloat(lat, 64); err != nil {
+			return err
+		}
+		if geo[1], err = strconv.ParseFloat(lon, 64); err != nil {
+			return err
+		}
+		ev.Geo = &geo
+	case "CATEGORIES":
+		for _, c := range splitText(value) {
+			ev.Categories = append(ev.Categories, unescape(c))
+		}
+	case "ORGANIZER":
+		ev.Organizer = &Organizer{Name: params["CN"], Email: mailto(value)}
+	case "ATTENDEE":
+		ev.Attendees = append(ev.Attendees, Attendee{
+			Name:     params["CN"],
+			Email:    mailto(value),
+			PartStat: strings.ToUpper(params["PARTSTAT"]),
+			RSVP:     strings.EqualFold(params["RSVP"], "TRUE"),
+		})
+	}
+
+	return err
+}
+
+func isDate(value string, params map[string]string) bool {
+	return strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(dateFormat)
+}
+
+// parseTime reads a DATE or DATE-TIME value.
+func (d decoder) parseTime(value string, params map[string]string) (time.Time, error) {
+	if isDate(value, params) {
+		return time.Parse(dateFormat, value)
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse(dateTimeFormat, value)
+	}
+
+	localFormat := strings.TrimSuffix(dateTimeFormat, "Z")
+	tzid := strings.TrimPrefix(params["TZID"], "/")
+	if tzid == "" {
+		return time.ParseInLocation(localFormat, value, d.floating)
+	}
+
+	if loc, err := time.LoadLocation(tzid); err == nil {
+		return time.ParseInLocation(localFormat, value, loc)
+	}
+
+	tz, ok := d.zones[tzid]
+	if !ok {
+		return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+	}
+	wall, err := time.Parse(localFormat, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.ParseInLocation(localFormat, value, tz.in(tzid, wall))
+}
+
+var durationPattern = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseDuration reads a DURATION value such as PT1H30M or P1D.
+func parseDuration(value string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("malformed duration %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(n) * unit
+	}
+
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// mailto returns the address of a CAL-ADDRESS value.
+func mailto(value string) string {
+	if len(value) >= len("mailto:") && strings.EqualFold(value[:len("mailto:")], "mailto:") {
+		return value[len("mailto:"):]
+	}
+	return value
+}
+
+// splitText splits a list of TEXT values on the commas that aren't escaped.
+func splitText(value string) []string {
+	var (
+		parts []string
+		start int
+	)
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+// unescape decodes a TEXT value.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
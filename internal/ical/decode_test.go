@@ -0,0 +1,134 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeTimes(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("time zone database unavailable: %v", err)
+	}
+
+	calendar := func(lines ...string) string {
+		return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VCALENDAR\r\n"
+	}
+	event := func(lines ...string) string {
+		return "BEGIN:VEVENT\r\nUID:1\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VEVENT"
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		floating  *time.Location
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "utc",
+			input:     calendar(event("DTSTART:20240721T173000Z", "DTEND:20240721T183000Z")),
+			wantStart: time.Date(2024, 7, 21, 17, 30, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 7, 21, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "iana time zone",
+			input:     calendar(event("DTSTART;TZID=America/Sao_Paulo:20240721T090000")),
+			wantStart: time.Date(2024, 7, 21, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "floating in the given zone",
+			input:     calendar(event("DTSTART:20240721T090000")),
+			floating:  saoPaulo,
+			wantStart: time.Date(2024, 7, 21, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "floating without zone",
+			input:     calendar(event("DTSTART:20240721T090000")),
+			wantStart: time.Date(2024, 7, 21, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "duration",
+			input:     calendar(event("DTSTART:20240721T090000Z", "DURATION:PT1H30M")),
+			wantStart: time.Date(2024, 7, 21, 9, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 7, 21, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "vtimezone",
+			input: calendar(
+				"BEGIN:VTIMEZONE",
+				"TZID:E. South America Standard Time",
+				"BEGIN:STANDARD",
+				"DTSTART:16010101T000000",
+				"TZOFFSETFROM:-0300",
+				"TZOFFSETTO:-0300",
+				"END:STANDARD",
+				"END:VTIMEZONE",
+				event(`DTSTART;TZID="E. South America Standard Time":20240721T090000`),
+			),
+			wantStart: time.Date(2024, 7, 21, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal, err := Decode(strings.NewReader(tt.input), tt.floating)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if len(cal.Events) != 1 {
+				t.Fatalf("Decode events = %d, want 1 (invalid: %+v)", len(cal.Events), cal.Invalid)
+			}
+
+			ev := cal.Events[0]
+			if !ev.Start.Equal(tt.wantStart) {
+				t.Errorf("Start = %v, want %v", ev.Start, tt.wantStart)
+			}
+			if !ev.End.Equal(tt.wantEnd) {
+				t.Errorf("End = %v, want %v", ev.End, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantErr     error
+		wantInvalid int
+	}{
+		{
+			name:    "no calendar",
+			input:   "BEGIN:VEVENT\r\nEND:VEVENT\r\n",
+			wantErr: ErrNoCalendar,
+		},
+		{
+			name:        "unknown time zone",
+			input:       "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART;TZID=Nowhere:20240721T090000\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			wantInvalid: 1,
+		},
+		{
+			name:        "malformed duration",
+			input:       "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART:20240721T090000Z\r\nDURATION:PT\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			wantInvalid: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cal, err := Decode(strings.NewReader(tt.input), nil)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Decode error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(cal.Events) != 0 || len(cal.Invalid) != tt.wantInvalid {
+				t.Errorf("Decode events = %d, invalid = %d, want 0 and %d", len(cal.Events), len(cal.Invalid), tt.wantInvalid)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Package ical reads and writes calendars in the iCalendar format of RFC
+// 5545.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ProdID identifies the application that produced a calendar.
+const ProdID = "-//EyzRyder//Travel Planner//EN"
+
+const (
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+	// maxLineLength is the length in octets lines are folded at.
+	maxLineLength = 75
+)
+
+// Calendar is a VCALENDAR object holding events.
+type Calendar struct {
+	// Method is the iTIP method of the calendar, left out when empty.
+	Method string
+	// Name is shown by calendar apps for subscribed calendars.
+	Name   string
+	Events []Event
+	// Invalid lists the events Decode couldn't read.
+	Invalid []InvalidEvent
+}
+
+// InvalidEvent is an event left out of a decoded calendar because one of
+// its properties couldn't be read, such as a time in an unknown time zone.
+type InvalidEvent struct {
+	UID     string
+	Summary string
+	Err     error
+}
+
+// Event is a VEVENT component. Times are written in UTC. All-day events
+// only use the dates of Start and End, where End is the day after the last
+// day of the event.
+type Event struct {
+	UID         string
+	Sequence    int
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Summary     string
+	Description string
+	Location    string
+	Categories  []string
+	// Status is CANCELLED for events that no longer happen, and empty
+	// otherwise.
+	Status string
+	// Geo holds the latitude and longitude of the event, if any.
+	Geo *[2]float64
+	// Organizer receives the replies of the attendees to a REQUEST.
+	Organizer *Organizer
+	Attendees []Attendee
+}
+
+// Organizer is the calendar user that organizes an event.
+type Organizer struct {
+	Name  string
+	Email string
+}
+
+// Attendee is a calendar user invited to an event.
+type Attendee struct {
+	Name  string
+	Email string
+	// PartStat is the participation status of the attendee, such as
+	// NEEDS-ACTION, ACCEPTED, DECLINED or TENTATIVE.
+	PartStat string
+	// RSVP asks the attendee to reply.
+	RSVP bool
+}
+
+// Participation statuses of an attendee.
+const (
+	PartStatNeedsAction = "NEEDS-ACTION"
+	PartStatAccepted    = "ACCEPTED"
+	PartStatDeclined    = "DECLINED"
+	PartStatTentative   = "TENTATIVE"
+)
+
+// StatusCancelled is the status of cancelled events.
+const StatusCancelled = "CANCELLED"
+
+// iTIP methods of a calendar.
+const (
+	MethodRequest = "REQUEST"
+	MethodReply   = "REPLY"
+)
+
+// Encode writes c to w, with lines ending in CRLF and folded to 75 octets.
+func (c Calendar) Encode(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	e := encoder{w: bw}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	if c.Method != "" {
+		e.line("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		e.line("X-WR-CALNAME", escape(c.Name))
+	}
+
+	for _, ev := range c.Events {
+		e.event(ev)
+	}
+
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) event(ev Event) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", ev.UID)
+	e.line("SEQUENCE", fmt.Sprint(ev.Sequence))
+	e.line("DTSTAMP", ev.Stamp.UTC().Format(dateTimeFormat))
+
+	if ev.AllDay {
+		e.line("DTSTART;VALUE=DATE", ev.Start.Format(dateFormat))
+		e.line("DTEND;VALUE=DATE", ev.End.Format(dateFormat))
+	} else {
+		e.line("DTSTART", ev.Start.UTC().Format(dateTimeFormat))
+		if !ev.End.IsZero() {
+			e.line("DTEND", ev.End.UTC().Format(dateTimeFormat))
+		}
+	}
+
+	e.line("SUMMARY", escape(ev.Summary))
+	if ev.Description != "" {
+		e.line("DESCRIPTION", escape(ev.Description))
+	}
+	if ev.Location != "" {
+		e.line("LOCATION", escape(ev.Location))
+	}
+	if ev.Geo != nil {
+		e.line("GEO", fmt.Sprintf("%f;%f", ev.Geo[0], ev.Geo[1]))
+	}
+	if len(ev.Categories) > 0 {
+		categories := make([]string, len(ev.Categories))
+		for i, c := range ev.Categories {
+			categories[i] = escape(c)
+		}
+		e.line("CATEGORIES", strings.Join(categories, ","))
+	}
+	if ev.Status != "" {
+		e.line("STATUS", ev.Status)
+	}
+	if ev.Organizer != nil {
+		e.line("ORGANIZER"+cn(ev.Organizer.Name), "mailto:"+ev.Organizer.Email)
+	}
+	for _, a := range ev.Attendees {
+		name := "ATTENDEE" + cn(a.Name)
+		if a.PartStat != "" {
+			name += ";PARTSTAT=" + a.PartStat
+		}
+		if a.RSVP {
+			name += ";RSVP=TRUE"
+		}
+		e.line(name, "mailto:"+a.Email)
+	}
+
+	e.line("END", "VEVENT")
+}
+
+// line writes a content line, folding it after every 75 octets without
+// splitting UTF-8 sequences.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	s := name + ":" + value
+	limit := maxLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(s[cut]) {
+			cut--
+		}
+		if _, e.err = e.w.WriteString(s[:cut] + "\r\n "); e.err != nil {
+			return
+		}
+		s = s[cut:]
+		// Continuation lines start with a space that counts towards the limit.
+		limit = maxLineLength - 1
+	}
+	_, e.err = e.w.WriteString(s + "\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// cn returns the CN parameter naming a calendar user, if any. Parameter
+// values can't hold double quotes, so those are dropped.
+func cn(name string) string {
+	if name == "" {
+		return ""
+	}
+	return `;CN="` + strings.ReplaceAll(name, `"`, "") + `"`
+}
+
+// escape encodes s as a TEXT value.
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
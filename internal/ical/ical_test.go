@@ -0,0 +1,128 @@
+package ical
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	stamp := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 7, 21, 17, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		cal  Calendar
+	}{
+		{
+			name: "timed event",
+			cal: Calendar{
+				Name: "Trip to Tokyo",
+				Events: []Event{{
+					UID:      "activity@example.com",
+					Sequence: 2,
+					Stamp:    stamp,
+					Start:    start,
+					End:      start.Add(90 * time.Minute),
+					Summary:  "Dinner",
+				}},
+			},
+		},
+		{
+			name: "all-day event",
+			cal: Calendar{
+				Events: []Event{{
+					UID:     "trip@example.com",
+					Stamp:   stamp,
+					Start:   time.Date(2024, 7, 21, 0, 0, 0, 0, time.UTC),
+					End:     time.Date(2024, 7, 25, 0, 0, 0, 0, time.UTC),
+					AllDay:  true,
+					Summary: "Tokyo",
+				}},
+			},
+		},
+		{
+			name: "escaped text and long lines",
+			cal: Calendar{
+				Events: []Event{{
+					UID:         "escaped@example.com",
+					Stamp:       stamp,
+					Start:       start,
+					Summary:     `Lunch; then a walk, maybe \ coffee`,
+					Description: "First line\nSecond line with accents: café, São Paulo, 東京 " + strings.Repeat("long ", 30),
+					Location:    "Rua Augusta, 1000",
+					Categories:  []string{"food", "with, comma"},
+					Geo:         &[2]float64{-23.55, -46.633333},
+				}},
+			},
+		},
+		{
+			name: "cancelled occurrence",
+			cal: Calendar{
+				Events: []Event{{
+					UID:     "cancelled@example.com",
+					Stamp:   stamp,
+					Start:   start,
+					Summary: "Museum",
+					Status:  StatusCancelled,
+				}},
+			},
+		},
+		{
+			name: "request with attendees",
+			cal: Calendar{
+				Method: MethodRequest,
+				Events: []Event{{
+					UID:       "request@example.com",
+					Stamp:     stamp,
+					Start:     start,
+					End:       start.Add(time.Hour),
+					Summary:   "Tokyo",
+					Organizer: &Organizer{Name: "Owner", Email: "owner@example.com"},
+					Attendees: []Attendee{
+						{Name: "Ana", Email: "ana@example.com", PartStat: PartStatNeedsAction, RSVP: true},
+						{Email: "bruno@example.com", PartStat: PartStatAccepted},
+					},
+				}},
+			},
+		},
+		{
+			name: "reply",
+			cal: Calendar{
+				Method: MethodReply,
+				Events: []Event{{
+					UID:       "reply@example.com",
+					Stamp:     stamp,
+					Start:     start,
+					Summary:   "Tokyo",
+					Attendees: []Attendee{{Email: "ana@example.com", PartStat: PartStatDeclined}},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.cal.Encode(&buf); err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+
+			for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				if len(line) > maxLineLength {
+					t.Errorf("line longer than %d octets: %q", maxLineLength, line)
+				}
+			}
+
+			got, err := Decode(&buf, nil)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.cal) {
+				t.Errorf("Decode(Encode(cal)) = %+v, want %+v", got, tt.cal)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package ical
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeZone is a VTIMEZONE component. They are only read for the TZIDs that
+// aren't IANA names, such as the Windows ones Outlook writes.
+type timeZone struct {
+	observances []observance
+}
+
+// observance is a STANDARD or DAYLIGHT component of a VTIMEZONE, in effect
+// from its onset until the onset of the next one.
+type observance struct {
+	// start is the first onset, in the local time before it.
+	start      time.Time
+	offsetFrom int
+	offsetTo   int
+	// The yearly rule the onset repeats with, when month isn't 0. week is
+	// the week of the month the onset falls on, -1 for the last one.
+	month   time.Month
+	week    int
+	weekday time.Weekday
+}
+
+// parseTimeZones reads the VTIMEZONE components of lines by TZID.
+func parseTimeZones(lines []string) (map[string]*timeZone, error) {
+	zones := make(map[string]*timeZone)
+
+	var (
+		tz  *timeZone
+		obs *observance
+	)
+	for n, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		name, _, value, err := parseLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("ical: line %d: %w", n+1, err)
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VTIMEZONE":
+			tz = &timeZone{}
+		case tz == nil:
+		case name == "BEGIN" && (value == "STANDARD" || value == "DAYLIGHT"):
+			obs = &observance{}
+		case name == "END" && obs != nil:
+			tz.observances = append(tz.observances, *obs)
+			obs = nil
+		case name == "END" && value == "VTIMEZONE":
+			tz = nil
+		case name == "TZID" && obs == nil:
+			zones[strings.TrimPrefix(value, "/")] = tz
+		case obs != nil:
+			if err := obs.set(name, value); err != nil {
+				return nil, fmt.Errorf("ical: line %d: %s: %w", n+1, name, err)
+			}
+		}
+	}
+
+	return zones, nil
+}
+
+var byDayPattern = regexp.MustCompile(`^([+-]?\d)?(SU|MO|TU|WE|TH|FR|SA)$`)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func (obs *observance) set(name, value string) error {
+	var err error
+
+	switch name {
+	case "DTSTART":
+		obs.start, err = time.Parse(strings.TrimSuffix(dateTimeFormat, "Z"), value)
+	case "TZOFFSETFROM":
+		obs.offsetFrom, err = parseOffset(value)
+	case "TZOFFSETTO":
+		obs.offsetTo, err = parseOffset(value)
+	case "RRULE":
+		obs.setRule(value)
+	}
+
+	return err
+}
+
+// setRule reads the yearly rules time zones use, such as
+// FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU. Other rules are ignored, leaving the
+// observance to only start once.
+func (obs *observance) setRule(value string) {
+	var (
+		month int
+		week  = 1
+		day   = ""
+	)
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			if !strings.EqualFold(val, "YEARLY") {
+				return
+			}
+		case "BYMONTH":
+			month, _ = strconv.Atoi(val)
+		case "BYDAY":
+			m := byDayPattern.FindStringSubmatch(strings.ToUpper(val))
+			if m == nil {
+				return
+			}
+			if m[1] != "" {
+				week, _ = strconv.Atoi(m[1])
+			}
+			day = m[2]
+		}
+	}
+
+	if month < 1 || month > 12 || day == "" || week == 0 {
+		return
+	}
+	obs.month, obs.week, obs.weekday = time.Month(month), week, weekdays[day]
+}
+
+// parseOffset reads a UTC-OFFSET value such as -0500 in seconds.
+func parseOffset(value string) (int, error) {
+	if len(value) != 5 && len(value) != 7 || (value[0] != '+' && value[0] != '-') {
+		return 0, fmt.Errorf("malformed offset %q", value)
+	}
+
+	var parts [3]int
+	for i := 0; 1+i*2 < len(value); i++ {
+		n, err := strconv.Atoi(value[1+i*2 : 3+i*2])
+		if err != nil {
+			return 0, fmt.Errorf("malformed offset %q", value)
+		}
+		parts[i] = n
+	}
+
+	offset := parts[0]*3600 + parts[1]*60 + parts[2]
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, nil
+}
+
+// onset returns when obs starts in year, if it repeats and does.
+func (obs observance) onset(year int) (time.Time, bool) {
+	var day time.Time
+	if obs.week > 0 {
+		first := time.Date(year, obs.month, 1, 0, 0, 0, 0, time.UTC)
+		day = first.AddDate(0, 0, (int(obs.weekday)-int(first.Weekday())+7)%7+(obs.week-1)*7)
+	} else {
+		last := time.Date(year, obs.month+1, 0, 0, 0, 0, 0, time.UTC)
+		day = last.AddDate(0, 0, -((int(last.Weekday())-int(obs.weekday)+7)%7)+(obs.week+1)*7)
+	}
+	if day.Month() != obs.month {
+		return time.Time{}, false
+	}
+
+	onset := time.Date(day.Year(), day.Month(), day.Day(),
+		obs.start.Hour(), obs.start.Minute(), obs.start.Second(), 0, time.UTC)
+	return onset, !onset.Before(obs.start)
+}
+
+// lastOnset returns the last time obs started at or before wall.
+func (obs observance) lastOnset(wall time.Time) (time.Time, bool) {
+	if obs.month == 0 {
+		return obs.start, !obs.start.After(wall)
+	}
+
+	for _, year := range []int{wall.Year(), wall.Year() - 1} {
+		if onset, ok := obs.onset(year); ok && !onset.After(wall) {
+			return onset, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// offset returns the UTC offset in seconds of the local time wall, read as
+// UTC, which is the one of the observance that started last before it.
+func (tz *timeZone) offset(wall time.Time) int {
+	var (
+		latest time.Time
+		found  *observance
+	)
+	for i, obs := range tz.observances {
+		if onset, ok := obs.lastOnset(wall); ok && (found == nil || onset.After(latest)) {
+			latest, found = onset, &tz.observances[i]
+		}
+	}
+	if found != nil {
+		return found.offsetTo
+	}
+
+	// Times before every observance use the offset the earliest one
+	// replaced.
+	for i, obs := range tz.observances {
+		if found == nil || obs.start.Before(found.start) {
+			found = &tz.observances[i]
+		}
+	}
+	if found != nil {
+		return found.offsetFrom
+	}
+	return 0
+}
+
+// in returns the time zone named name with the offset of tz at wall.
+func (tz *timeZone) in(name string, wall time.Time) *time.Location {
+	return time.FixedZone(name, tz.offset(wall))
+}
@@ -0,0 +1,186 @@
+package mailpit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/wneessen/go-mail"
+)
+
+// Delivery is the outcome of the e-mail sent to one participant of a
+// batch. Err is nil when the SMTP server accepted it.
+type Delivery struct {
+	ParticipantID uuid.UUID
+	Email         string
+	Err           error
+}
+
+// sendBatch sends the e-mail build returns for each participant, and
+// reports the outcome of each one. Up to Concurrency workers build and send
+// them at the same time, each over its own SMTP session, sharing a limit of
+// RateLimit e-mails per second. An error is only returned when no session
+// can be opened, otherwise failures are reported in the delivery of the
+// participant they concern.
+func (mp Mailpit) sendBatch(
+	ctx context.Context,
+	participants []pgstore.Participant,
+	build func(pgstore.Participant) (*mail.Msg, error),
+) ([]Delivery, error) {
+	deliveries := make([]Delivery, len(participants))
+	for i, p := range participants {
+		deliveries[i] = Delivery{ParticipantID: p.ID, Email: p.Email}
+	}
+	if len(participants) == 0 {
+		return deliveries, nil
+	}
+
+	// The first session is opened up front, so the whole batch is tried
+	// again later when the server can't be reached.
+	first, err := mp.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := range participants {
+			jobs <- i
+		}
+	}()
+
+	limit := newLimiter(mp.config.RateLimit)
+
+	var wg sync.WaitGroup
+	for w := range max(min(mp.config.Concurrency, len(participants)), 1) {
+		var c *mail.Client
+		if w == 0 {
+			c = first
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s := session{mp: mp, c: c}
+			defer s.close()
+
+			// Each worker writes the deliveries of the participants it
+			// takes, so they are never written twice.
+			for i := range jobs {
+				deliveries[i].Err = s.send(ctx, limit, participants[i], build)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return deliveries, nil
+}
+
+// session is the SMTP session of a worker of sendBatch, opened again once
+// the server drops it.
+type session struct {
+	mp Mailpit
+	c  *mail.Client
+}
+
+// send builds and sends the e-mail of participant, once limit allows it.
+func (s *session) send(
+	ctx context.Context,
+	limit *limiter,
+	participant pgstore.Participant,
+	build func(pgstore.Participant) (*mail.Msg, error),
+) error {
+	msg, err := build(participant)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to build email: %w", err)
+	}
+
+	if s.c == nil {
+		if s.c, err = s.mp.dial(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := limit.wait(ctx); err != nil {
+		return err
+	}
+
+	// Send also fails when the session breaks right after the server
+	// accepted the e-mail, which then counts as sent.
+	err = s.c.Send(msg)
+
+	var sendErr *mail.SendError
+	if errors.As(err, &sendErr) && sendErr.Reason == mail.ErrConnCheck {
+		s.close()
+	}
+
+	if err != nil && !msg.IsDelivered() {
+		return fmt.Errorf("mailpit: failed to send email: %w", err)
+	}
+	return nil
+}
+
+func (s *session) close() {
+	if s.c != nil {
+		s.c.Close()
+		s.c = nil
+	}
+}
+
+// limiter spaces the e-mails sent by every worker of a batch to a rate per
+// second. A rate of 0 or less doesn't limit them.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(rate float64) *limiter {
+	l := &limiter{}
+	if rate > 0 {
+		l.interval = time.Duration(float64(time.Second) / rate)
+	}
+	return l
+}
+
+// wait blocks until the next e-mail may be sent, or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	at := time.Now()
+	if l.next.After(at) {
+		at = l.next
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dial opens a session with the configured SMTP server.
+func (mp Mailpit) dial(ctx context.Context) (*mail.Client, error) {
+	c, err := mp.client()
+	if err != nil {
+		return nil, fmt.Errorf("mailpit: failed to create email client: %w", err)
+	}
+	if err := c.DialWithContext(ctx); err != nil {
+		return nil, fmt.Errorf("mailpit: failed to connect to SMTP server: %w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,101 @@
+package mailpit
+
+import (
+	"fmt"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/wneessen/go-mail"
+)
+
+// Config sets the SMTP server e-mails are sent through, the address they
+// are sent from and where their links point to.
+type Config struct {
+	Host string
+	Port int
+	// TLS is none, opportunistic, mandatory or implicit, the latter for
+	// servers that expect TLS from the start, usually on port 465.
+	TLS string
+	// Auth is the SASL mechanism, PLAIN, LOGIN or CRAM-MD5. E-mails are sent
+	// without authentication when Username is empty.
+	Auth     string
+	Username string
+	Password string
+	From     string
+	// BaseURL is the public URL of the API, which the links in the e-mails
+	// point to.
+	BaseURL string
+	// Concurrency is how many SMTP sessions send the e-mails of a trip at
+	// once, and RateLimit how many they send per second in all, unlimited
+	// when 0.
+	Concurrency int
+	RateLimit   float64
+}
+
+// DefaultConfig sends e-mails to the Mailpit container of
+// docker-compose.dev.yml.
+func DefaultConfig() Config {
+	return Config{
+		Host:    "mailpit",
+		Port:    1025,
+		TLS:     "none",
+		Auth:    string(mail.SMTPAuthPlain),
+		From:    "mailpit@journey.com",
+		BaseURL: "http://localhost:8080",
+
+		Concurrency: 4,
+		RateLimit:   10,
+	}
+}
+
+// options converts c to the options of the SMTP clients.
+func (c Config) options() ([]mail.Option, error) {
+	if c.Host == "" {
+		return nil, fmt.Errorf("mailpit: missing SMTP host")
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return nil, fmt.Errorf("mailpit: invalid SMTP port %d", c.Port)
+	}
+	if _, err := netmail.ParseAddress(c.From); err != nil {
+		return nil, fmt.Errorf("mailpit: invalid from address %q: %w", c.From, err)
+	}
+
+	if u, err := url.Parse(c.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("mailpit: invalid base URL %q, expected an absolute http or https URL", c.BaseURL)
+	}
+
+	if c.Concurrency < 1 {
+		return nil, fmt.Errorf("mailpit: invalid concurrency %d, expected at least 1", c.Concurrency)
+	}
+	if c.RateLimit < 0 {
+		return nil, fmt.Errorf("mailpit: invalid rate limit %g, expected 0 or more", c.RateLimit)
+	}
+
+	var opts []mail.Option
+	switch strings.ToLower(c.TLS) {
+	case "none":
+		opts = append(opts, mail.WithTLSPortPolicy(mail.NoTLS))
+	case "opportunistic":
+		opts = append(opts, mail.WithTLSPortPolicy(mail.TLSOpportunistic))
+	case "mandatory":
+		opts = append(opts, mail.WithTLSPortPolicy(mail.TLSMandatory))
+	case "implicit":
+		opts = append(opts, mail.WithSSL())
+	default:
+		return nil, fmt.Errorf("mailpit: unknown TLS policy %q", c.TLS)
+	}
+	opts = append(opts, mail.WithPort(c.Port))
+
+	if c.Username != "" {
+		auth := mail.SMTPAuthType(strings.ToUpper(c.Auth))
+		switch auth {
+		case mail.SMTPAuthPlain, mail.SMTPAuthLogin, mail.SMTPAuthCramMD5:
+		default:
+			return nil, fmt.Errorf("mailpit: unknown SMTP auth mechanism %q", c.Auth)
+		}
+		opts = append(opts, mail.WithSMTPAuth(auth), mail.WithUsername(c.Username), mail.WithPassword(c.Password))
+	}
+
+	return opts, nil
+}
@@ -3,9 +3,14 @@ package mailpit
 import (
 	"context"
 	"fmt"
+	netmail "net/mail"
+	"net/url"
+	"strings"
 	"time"
 
-    "github.com/EyzRyder/Travel-Planner/internal/pgstore"
+	"github.com/EyzRyder/Travel-Planner/internal/auth"
+	"github.com/EyzRyder/Travel-Planner/internal/ical"
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -13,29 +18,42 @@ import (
 )
 
 type Store interface {
-    GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
-    GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
+	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	GetParticipant(ctx context.Context, participantID uuid.UUID) (pgstore.Participant, error)
 	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
 }
 
-type Mailpit struct{
-    store Store
+// Mailpit sends the e-mails of the application through the SMTP server of
+// its Config, the Mailpit container in development.
+type Mailpit struct {
+	store   Store
+	signer  auth.Signer
+	config  Config
+	options []mail.Option
 }
 
-func NewMailpit(pool *pgxpool.Pool) Mailpit{
-    return Mailpit{store: pgstore.New(pool)}
+func NewMailpit(pool *pgxpool.Pool, signer auth.Signer, config Config) (Mailpit, error) {
+	options, err := config.options()
+	if err != nil {
+		return Mailpit{}, err
+	}
+	return Mailpit{store: pgstore.New(pool), signer: signer, config: config, options: options}, nil
 }
 
-func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
-    ctx := context.Background()
-    trip, err := mp.store.GetTrip(ctx,tripID)
-    if err != nil {
-        return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
-    }
+// client returns a new client of the configured SMTP server.
+func (mp Mailpit) client() (*mail.Client, error) {
+	return mail.NewClient(mp.config.Host, mp.options...)
+}
 
-    msg := mail.NewMsg()
+func (mp Mailpit) SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
+	}
 
-	if err := msg.From("mailpit@journey.com"); err != nil {
+	msg := mail.NewMsg()
+
+	if err := msg.From(mp.config.From); err != nil {
 		return fmt.Errorf("mailpit: failed to set 'From' in email SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
@@ -43,87 +61,345 @@ func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
 		return fmt.Errorf("mailpit: failed to set 'to' in email SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-	msg.Subject("Confirme sua viagem")
-	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
-		Olá, %s!
+	token, err := mp.signer.Owner(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to issue token for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	locale := tripLocale(trip)
+	data := tripData(locale, trip)
+	data.Token = token
+	if data.ConfirmURL, err = mp.link(token, "trips", trip.ID.String(), "confirm"); err != nil {
+		return err
+	}
 
-		A sua viagem para %s que começa no dia %s precisa ser confirmada.
-		Clique no botão abaixo para confirmar.
-		`,
-		trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
-	))
+	m, err := render(locale, confirmTripEmail, data)
+	if err != nil {
+		return err
+	}
+	m.apply(msg)
 
-	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	client, err := mp.client()
 	if err != nil {
 		return fmt.Errorf("mailpit: failed create email client SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-	if err := client.DialAndSend(msg); err != nil {
+	if err := client.DialAndSendWithContext(ctx, msg); err != nil {
 		return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-    return nil
+	return nil
 }
 
-func (mp Mailpit) SendTripConfirmedEmails(tripID uuid.UUID) error {
-    participants, err := mp.store.GetParticipants(context.Background(), tripID)
+// SendTripConfirmedEmails invites the participants of tripID that haven't
+// declined, and reports the outcome for each one of them.
+func (mp Mailpit) SendTripConfirmedEmails(ctx context.Context, tripID uuid.UUID) ([]Delivery, error) {
+	trip, err := mp.store.GetTrip(ctx, tripID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	c, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	participants, err := mp.store.GetParticipants(ctx, tripID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	invited := participants[:0]
 	for _, p := range participants {
-		msg := mail.NewMsg()
-		if err := msg.From("mailpit@journey.com"); err != nil {
-			return err
+		if p.RsvpStatus != pgstore.RsvpStatusDeclined && p.RsvpStatus != pgstore.RsvpStatusRemoved {
+			invited = append(invited, p)
 		}
+	}
 
-		if err := msg.To(p.Email); err != nil {
-			return err
-		}
+	return mp.sendBatch(ctx, invited, func(p pgstore.Participant) (*mail.Msg, error) {
+		return mp.inviteMsg(trip, p)
+	})
+}
 
-		msg.Subject("Confirme sua viagem")
-		msg.SetBodyString(mail.TypeTextPlain, "Você deve confirmar sua viagem")
+func (mp Mailpit) SendTripConfirmedEmail(ctx context.Context, tripID, participantID uuid.UUID) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return err
+	}
 
-		if err := c.DialAndSend(msg); err != nil {
-			return err
-		}
+	participant, err := mp.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return err
+	}
+
+	msg, err := mp.inviteMsg(trip, participant)
+	if err != nil {
+		return err
+	}
+
+	c, err := mp.client()
+	if err != nil {
+		return err
+	}
+
+	if err := c.DialAndSendWithContext(ctx, msg); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (mp Mailpit) SendTripConfirmedEmail(tripID, participantID uuid.UUID) error {
-	ctx := context.Background()
+// inviteMsg builds the e-mail inviting participant to confirm trip, in the
+// locale they prefer.
+func (mp Mailpit) inviteMsg(trip pgstore.Trip, participant pgstore.Participant) (*mail.Msg, error) {
+	msg := mail.NewMsg()
+	if err := msg.From(mp.config.From); err != nil {
+		return nil, err
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		return nil, err
+	}
+
+	token, err := mp.signer.Participant(trip.ID, participant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := participantLocale(trip, participant)
+	data := tripData(locale, trip)
+	data.Token = token
+	if data.ConfirmURL, err = mp.link(token, "participants", participant.ID.String(), "confirm"); err != nil {
+		return nil, err
+	}
+
+	m, err := render(locale, inviteEmail, data)
+	if err != nil {
+		return nil, err
+	}
+	m.apply(msg)
+
+	if err := attachInvite(msg, trip, participant, locale, mp.config.From); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// SendTripUpdatedEmails lets the confirmed participants of tripID know the
+// trip changed, over the sessions of a batch, and reports the outcome of
+// each e-mail.
+func (mp Mailpit) SendTripUpdatedEmails(ctx context.Context, tripID uuid.UUID) ([]Delivery, error) {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("mailpit: failed to get trip for SendTripUpdatedEmails: %w", err)
+	}
+
+	participants, err := mp.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		return nil, fmt.Errorf("mailpit: failed to get participants for SendTripUpdatedEmails: %w", err)
+	}
+
+	confirmed := participants[:0]
+	for _, p := range participants {
+		if p.IsConfirmed {
+			confirmed = append(confirmed, p)
+		}
+	}
+
+	return mp.sendBatch(ctx, confirmed, func(p pgstore.Participant) (*mail.Msg, error) {
+		return mp.tripUpdatedMsg(trip, p)
+	})
+}
+
+// SendTripUpdatedEmail lets participantID alone know tripID changed, when
+// the e-mail sent to every participant failed for them.
+func (mp Mailpit) SendTripUpdatedEmail(ctx context.Context, tripID, participantID uuid.UUID) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripUpdatedEmail: %w", err)
+	}
+
 	participant, err := mp.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant for SendTripUpdatedEmail: %w", err)
+	}
+
+	msg, err := mp.tripUpdatedMsg(trip, participant)
 	if err != nil {
 		return err
 	}
 
+	c, err := mp.client()
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendTripUpdatedEmail: %w", err)
+	}
+
+	return c.DialAndSendWithContext(ctx, msg)
+}
+
+// tripUpdatedMsg builds the e-mail letting participant know trip changed,
+// in the locale they prefer.
+func (mp Mailpit) tripUpdatedMsg(trip pgstore.Trip, participant pgstore.Participant) (*mail.Msg, error) {
 	msg := mail.NewMsg()
-	if err := msg.From("mailpit@journey.com"); err != nil {
-		return err
+	if err := msg.From(mp.config.From); err != nil {
+		return nil, err
 	}
 
 	if err := msg.To(participant.Email); err != nil {
-		return err
+		return nil, err
 	}
 
-	msg.Subject("Confirme sua viagem")
-	msg.SetBodyString(mail.TypeTextPlain, "Você deve confirmar sua viagem")
+	locale := participantLocale(trip, participant)
+	m, err := render(locale, tripUpdatedEmail, tripData(locale, trip))
+	if err != nil {
+		return nil, err
+	}
+	m.apply(msg)
 
-	c, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	return msg, nil
+}
+
+func (mp Mailpit) SendRSVPChangedEmailToTripOwner(ctx context.Context, tripID, participantID uuid.UUID) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendRSVPChangedEmailToTripOwner: %w", err)
+	}
+
+	participant, err := mp.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant for SendRSVPChangedEmailToTripOwner: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From(mp.config.From); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendRSVPChangedEmailToTripOwner: %w", err)
+	}
+
+	if err := msg.To(trip.OwnerEmail); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendRSVPChangedEmailToTripOwner: %w", err)
+	}
+
+	locale := tripLocale(trip)
+	data := tripData(locale, trip)
+	data.Who = participant.Email
+	if participant.Name.Valid {
+		data.Who = participant.Name.String
+	}
+	data.Status = rsvpLabels[locale][participant.RsvpStatus]
+	data.Note = participant.RsvpNote.String
+
+	m, err := render(locale, rsvpChangedEmail, data)
 	if err != nil {
 		return err
 	}
+	m.apply(msg)
 
-	if err := c.DialAndSend(msg); err != nil {
+	c, err := mp.client()
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendRSVPChangedEmailToTripOwner: %w", err)
+	}
+
+	if err := c.DialAndSendWithContext(ctx, msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendRSVPChangedEmailToTripOwner: %w", err)
+	}
+
+	return nil
+}
+
+func (mp Mailpit) SendParticipantRemovedEmail(ctx context.Context, tripID uuid.UUID, email string) error {
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendParticipantRemovedEmail: %w", err)
+	}
+
+	msg := mail.NewMsg()
+	if err := msg.From(mp.config.From); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendParticipantRemovedEmail: %w", err)
+	}
+
+	if err := msg.To(email); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendParticipantRemovedEmail: %w", err)
+	}
+
+	// The participant is gone by now, so the e-mail follows the trip locale.
+	locale := tripLocale(trip)
+	m, err := render(locale, participantRemovedEmail, tripData(locale, trip))
+	if err != nil {
 		return err
 	}
+	m.apply(msg)
+
+	c, err := mp.client()
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client SendParticipantRemovedEmail: %w", err)
+	}
+
+	if err := c.DialAndSendWithContext(ctx, msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendParticipantRemovedEmail: %w", err)
+	}
 
 	return nil
 }
+
+// link returns the URL of the API path made of elem under the public base
+// URL, authenticated with token.
+func (mp Mailpit) link(token string, elem ...string) (string, error) {
+	u, err := url.JoinPath(mp.config.BaseURL, elem...)
+	if err != nil {
+		return "", fmt.Errorf("mailpit: failed to build link: %w", err)
+	}
+	return u + "?token=" + url.QueryEscape(token), nil
+}
+
+// inviteStatuses tells mail clients how each participant already answered
+// the invitation.
+var inviteStatuses = map[pgstore.RsvpStatus]string{
+	pgstore.RsvpStatusInvited:  ical.PartStatNeedsAction,
+	pgstore.RsvpStatusAccepted: ical.PartStatAccepted,
+	pgstore.RsvpStatusDeclined: ical.PartStatDeclined,
+	pgstore.RsvpStatusMaybe:    ical.PartStatTentative,
+}
+
+// attachInvite adds to msg the invitation of participant to trip as an
+// iCalendar REQUEST, so mail clients show buttons to answer it. The
+// organizer is the sender of the e-mails, with its display name, since
+// answers are sent to its address and are applied once forwarded to POST
+// /calendar/replies.
+func attachInvite(msg *mail.Msg, trip pgstore.Trip, participant pgstore.Participant, locale pgstore.Locale, from string) error {
+	organizer, err := netmail.ParseAddress(from)
+	if err != nil {
+		return fmt.Errorf("mailpit: invalid from address for trip invite: %w", err)
+	}
+
+	loc := trip.Zone()
+	startsAt := trip.StartsAt.Time.In(loc)
+	endsAt := trip.EndsAt.Time.In(loc)
+
+	cal := ical.Calendar{
+		Method: ical.MethodRequest,
+		Events: []ical.Event{{
+			UID:     trip.CalendarUID(),
+			Stamp:   time.Now(),
+			AllDay:  true,
+			Start:   time.Date(startsAt.Year(), startsAt.Month(), startsAt.Day(), 0, 0, 0, 0, time.UTC),
+			End:     time.Date(endsAt.Year(), endsAt.Month(), endsAt.Day()+1, 0, 0, 0, 0, time.UTC),
+			Summary: inviteSummaries[locale] + trip.Destination,
+			Organizer: &ical.Organizer{
+				Name:  organizer.Name,
+				Email: organizer.Address,
+			},
+			Attendees: []ical.Attendee{{
+				Name:     participant.Name.String,
+				Email:    participant.Email,
+				PartStat: inviteStatuses[participant.RsvpStatus],
+				RSVP:     true,
+			}},
+		}},
+	}
+
+	var invite strings.Builder
+	if err := cal.Encode(&invite); err != nil {
+		return fmt.Errorf("mailpit: failed to encode trip invite: %w", err)
+	}
+
+	msg.AddAlternativeString(mail.ContentType("text/calendar; method="+ical.MethodRequest), invite.String())
+	return msg.AttachReader(inviteFilenames[locale], strings.NewReader(invite.String()),
+		mail.WithFileContentType(mail.ContentType("application/ics")),
+	)
+}
@@ -0,0 +1,178 @@
+package mailpit
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/wneessen/go-mail"
+)
+
+// templatesFS holds a text and an HTML template per e-mail and locale. The
+// text ones define the subject, and the HTML ones the content of
+// layout.html.
+//
+//go:embed templates
+var templatesFS embed.FS
+
+// E-mail templates, named after their files.
+const (
+	confirmTripEmail        = "confirm_trip"
+	inviteEmail             = "invite"
+	tripUpdatedEmail        = "trip_updated"
+	rsvpChangedEmail        = "rsvp_changed"
+	participantRemovedEmail = "participant_removed"
+)
+
+var locales = []pgstore.Locale{pgstore.LocalePtBR, pgstore.LocaleEn}
+
+// dateFormats sets how dates are written in each locale.
+var dateFormats = map[pgstore.Locale]string{
+	pgstore.LocalePtBR: "02/01/2006",
+	pgstore.LocaleEn:   "Jan 2, 2006",
+}
+
+// rsvpLabels describes each RSVP state in the e-mails sent to the owner.
+var rsvpLabels = map[pgstore.Locale]map[pgstore.RsvpStatus]string{
+	pgstore.LocalePtBR: {
+		pgstore.RsvpStatusInvited:  "convidado",
+		pgstore.RsvpStatusAccepted: "vai participar",
+		pgstore.RsvpStatusDeclined: "não vai participar",
+		pgstore.RsvpStatusMaybe:    "talvez",
+		pgstore.RsvpStatusRemoved:  "removido da viagem",
+	},
+	pgstore.LocaleEn: {
+		pgstore.RsvpStatusInvited:  "invited",
+		pgstore.RsvpStatusAccepted: "going",
+		pgstore.RsvpStatusDeclined: "not going",
+		pgstore.RsvpStatusMaybe:    "maybe",
+		pgstore.RsvpStatusRemoved:  "removed from the trip",
+	},
+}
+
+// inviteSummaries names the trip in the invitations attached to e-mails.
+var inviteSummaries = map[pgstore.Locale]string{
+	pgstore.LocalePtBR: "Viagem para ",
+	pgstore.LocaleEn:   "Trip to ",
+}
+
+// inviteFilenames names the invitation attached to e-mails.
+var inviteFilenames = map[pgstore.Locale]string{
+	pgstore.LocalePtBR: "convite.ics",
+	pgstore.LocaleEn:   "invite.ics",
+}
+
+var (
+	textTemplates = make(map[string]*texttemplate.Template)
+	htmlTemplates = make(map[string]*htmltemplate.Template)
+)
+
+func init() {
+	for _, locale := range locales {
+		for _, name := range []string{
+			confirmTripEmail, inviteEmail, tripUpdatedEmail, rsvpChangedEmail, participantRemovedEmail,
+		} {
+			key := templateKey(locale, name)
+			textTemplates[key] = texttemplate.Must(texttemplate.ParseFS(templatesFS, "templates/"+key+".txt"))
+			htmlTemplates[key] = htmltemplate.Must(htmltemplate.ParseFS(templatesFS,
+				"templates/layout.html", "templates/"+key+".html",
+			))
+		}
+	}
+}
+
+func templateKey(locale pgstore.Locale, name string) string {
+	return string(locale) + "/" + name
+}
+
+// emailData holds the fields the templates may use. Dates are already
+// formatted in the locale of the e-mail.
+type emailData struct {
+	Locale      string
+	Subject     string
+	OwnerName   string
+	Destination string
+	StartsAt    string
+	EndsAt      string
+	Token       string
+	ConfirmURL  string
+	Who         string
+	Status      string
+	Note        string
+}
+
+// tripData returns the fields describing trip in locale.
+func tripData(locale pgstore.Locale, trip pgstore.Trip) emailData {
+	loc := trip.Zone()
+	return emailData{
+		Locale:      string(locale),
+		OwnerName:   trip.OwnerName,
+		Destination: trip.Destination,
+		StartsAt:    trip.StartsAt.Time.In(loc).Format(dateFormats[locale]),
+		EndsAt:      trip.EndsAt.Time.In(loc).Format(dateFormats[locale]),
+	}
+}
+
+// participantLocale returns the locale participant prefers, falling back to
+// the one of their trip.
+func participantLocale(trip pgstore.Trip, participant pgstore.Participant) pgstore.Locale {
+	if participant.Locale.Valid && dateFormats[participant.Locale.Locale] != "" {
+		return participant.Locale.Locale
+	}
+	return tripLocale(trip)
+}
+
+// tripLocale returns the locale of trip, or pt-BR if it isn't supported.
+func tripLocale(trip pgstore.Trip) pgstore.Locale {
+	if dateFormats[trip.Locale] != "" {
+		return trip.Locale
+	}
+	return pgstore.LocalePtBR
+}
+
+// message is a rendered e-mail.
+type message struct {
+	subject string
+	text    string
+	html    string
+}
+
+// render executes the templates of the e-mail called name in locale.
+func render(locale pgstore.Locale, name string, data emailData) (message, error) {
+	key := templateKey(locale, name)
+	text, html := textTemplates[key], htmlTemplates[key]
+	if text == nil || html == nil {
+		return message{}, fmt.Errorf("mailpit: no %q template", key)
+	}
+	data.Locale = string(locale)
+
+	var subject, body bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return message{}, fmt.Errorf("mailpit: failed to render subject of %q: %w", key, err)
+	}
+	data.Subject = strings.TrimSpace(subject.String())
+
+	if err := text.Execute(&body, data); err != nil {
+		return message{}, fmt.Errorf("mailpit: failed to render text of %q: %w", key, err)
+	}
+
+	var page bytes.Buffer
+	if err := html.ExecuteTemplate(&page, "layout", data); err != nil {
+		return message{}, fmt.Errorf("mailpit: failed to render html of %q: %w", key, err)
+	}
+
+	return message{subject: data.Subject, text: body.String(), html: page.String()}, nil
+}
+
+// apply sets the subject and bodies of msg, with the HTML one as the
+// alternative mail clients prefer.
+func (m message) apply(msg *mail.Msg) {
+	msg.Subject(m.subject)
+	msg.SetBodyString(mail.TypeTextPlain, m.text)
+	msg.AddAlternativeString(mail.TypeTextHTML, m.html)
+}
@@ -0,0 +1,246 @@
+// Package outbox delivers the e-mails queued in the email_outbox table
+// along with the changes they announce.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EyzRyder/Travel-Planner/internal/mailpit"
+	"github.com/EyzRyder/Travel-Planner/internal/pgstore"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+type Store interface {
+	ClaimEmails(ctx context.Context, params pgstore.ClaimEmailsParams) ([]pgstore.EmailOutbox, error)
+	MarkEmailSent(ctx context.Context, id uuid.UUID) error
+	MarkEmailFailed(ctx context.Context, params pgstore.MarkEmailFailedParams) error
+	EnqueueEmail(ctx context.Context, params pgstore.EnqueueEmailParams) error
+}
+
+type Mailer interface {
+	SendConfirmTripEmailToTripOwner(ctx context.Context, tripID uuid.UUID) error
+	SendTripConfirmedEmails(ctx context.Context, tripID uuid.UUID) ([]mailpit.Delivery, error)
+	SendTripConfirmedEmail(ctx context.Context, tripID, participantID uuid.UUID) error
+	SendTripUpdatedEmails(ctx context.Context, tripID uuid.UUID) ([]mailpit.Delivery, error)
+	SendTripUpdatedEmail(ctx context.Context, tripID, participantID uuid.UUID) error
+	SendRSVPChangedEmailToTripOwner(ctx context.Context, tripID, participantID uuid.UUID) error
+	SendParticipantRemovedEmail(ctx context.Context, tripID uuid.UUID, email string) error
+}
+
+// Config sets how often and how many times e-mails are tried.
+type Config struct {
+	// Interval is how often the outbox is checked for e-mails to send.
+	Interval  time.Duration
+	BatchSize int32
+	// Lease hides the e-mails a worker is sending from the other ones, and
+	// must be longer than it takes to send a batch.
+	Lease time.Duration
+	// Backoff is the delay before the second attempt, doubled on each
+	// attempt after it up to MaxBackoff.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// MaxAttempts is how many times an e-mail is tried before it is moved
+	// to the dead state.
+	MaxAttempts int32
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval:    5 * time.Second,
+		BatchSize:   20,
+		Lease:       5 * time.Minute,
+		Backoff:     30 * time.Second,
+		MaxBackoff:  time.Hour,
+		MaxAttempts: 8,
+	}
+}
+
+// backoff returns the delay before the attempt following the given one.
+func (c Config) backoff(attempts int32) time.Duration {
+	d := c.Backoff
+	for i := int32(1); i < attempts && d < c.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, c.MaxBackoff)
+}
+
+// Worker sends the e-mails of the outbox. E-mails are sent at least once:
+// one that fails after being sent to some participants is sent to all of
+// them again on the next attempt. The invitations sent when a trip is
+// confirmed are the exception, as those that fail are queued again one
+// participant at a time.
+type Worker struct {
+	store  Store
+	mailer Mailer
+	logger *zap.Logger
+	config Config
+}
+
+func NewWorker(pool *pgxpool.Pool, mailer Mailer, logger *zap.Logger, config Config) Worker {
+	return Worker{pgstore.New(pool), mailer, logger.Named("outbox"), config}
+}
+
+// Run sends the e-mails due every interval until ctx is done.
+func (wk Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(wk.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		wk.sendDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendDue sends the e-mails due, a batch at a time.
+func (wk Worker) sendDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		emails, err := wk.store.ClaimEmails(ctx, pgstore.ClaimEmailsParams{
+			LeaseUntil: pgtype.Timestamptz{Time: time.Now().Add(wk.config.Lease), Valid: true},
+			BatchSize:  wk.config.BatchSize,
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				wk.logger.Error("failed to claim emails", zap.Error(err))
+			}
+			return
+		}
+
+		// The e-mails left when shutting down are claimed again once their
+		// lease runs out.
+		for _, email := range emails {
+			if ctx.Err() != nil {
+				return
+			}
+			wk.deliver(ctx, email)
+		}
+
+		if len(emails) < int(wk.config.BatchSize) {
+			return
+		}
+	}
+}
+
+// deliver sends email and records the outcome. E-mails about trips or
+// participants that no longer exist can't ever be sent, so they are moved
+// to the dead state right away.
+func (wk Worker) deliver(ctx context.Context, email pgstore.EmailOutbox) {
+	fields := []zap.Field{
+		zap.String("email_id", email.ID.String()),
+		zap.String("kind", string(email.Kind)),
+		zap.String("trip_id", email.TripID.String()),
+		zap.Int32("attempts", email.Attempts),
+	}
+
+	// Sending stops when shutting down, but the outcome is still recorded
+	// so sent e-mails aren't sent again.
+	err := wk.send(ctx, email)
+	recordCtx := context.WithoutCancel(ctx)
+	if err == nil {
+		if err := wk.store.MarkEmailSent(recordCtx, email.ID); err != nil {
+			wk.logger.Error("failed to mark email as sent", append(fields, zap.Error(err))...)
+		}
+		return
+	}
+
+	params := pgstore.MarkEmailFailedParams{
+		ID:            email.ID,
+		Status:        pgstore.EmailStatusPending,
+		LastError:     pgtype.Text{String: err.Error(), Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(wk.config.backoff(email.Attempts)), Valid: true},
+	}
+
+	// E-mails interrupted by a shutdown are tried again as soon as the
+	// worker is back.
+	if ctx.Err() != nil {
+		params.NextAttemptAt.Time = time.Now()
+		wk.logger.Warn("email interrupted by shutdown, retrying", append(fields, zap.Error(err))...)
+	} else if email.Attempts >= wk.config.MaxAttempts || errors.Is(err, pgx.ErrNoRows) {
+		params.Status = pgstore.EmailStatusDead
+		wk.logger.Error("failed to send email, giving up", append(fields, zap.Error(err))...)
+	} else {
+		wk.logger.Warn("failed to send email, retrying",
+			append(fields, zap.Error(err), zap.Time("next_attempt_at", params.NextAttemptAt.Time))...,
+		)
+	}
+
+	if err := wk.store.MarkEmailFailed(recordCtx, params); err != nil {
+		wk.logger.Error("failed to mark email as failed", append(fields, zap.Error(err))...)
+	}
+}
+
+// send sends email through the mailer method of its kind.
+func (wk Worker) send(ctx context.Context, email pgstore.EmailOutbox) error {
+	participantID := uuid.UUID(email.ParticipantID.Bytes)
+
+	switch email.Kind {
+	case pgstore.EmailKindTripCreated:
+		return wk.mailer.SendConfirmTripEmailToTripOwner(ctx, email.TripID)
+	case pgstore.EmailKindTripConfirmed:
+		deliveries, err := wk.mailer.SendTripConfirmedEmails(ctx, email.TripID)
+		if err != nil {
+			return err
+		}
+		return wk.requeueFailed(context.WithoutCancel(ctx), email, pgstore.EmailKindParticipantInvited, deliveries)
+	case pgstore.EmailKindParticipantInvited:
+		return wk.mailer.SendTripConfirmedEmail(ctx, email.TripID, participantID)
+	case pgstore.EmailKindTripUpdated:
+		// The ones queued again for a single participant only go to them.
+		if email.ParticipantID.Valid {
+			return wk.mailer.SendTripUpdatedEmail(ctx, email.TripID, participantID)
+		}
+		deliveries, err := wk.mailer.SendTripUpdatedEmails(ctx, email.TripID)
+		if err != nil {
+			return err
+		}
+		return wk.requeueFailed(context.WithoutCancel(ctx), email, pgstore.EmailKindTripUpdated, deliveries)
+	case pgstore.EmailKindRsvpChanged:
+		return wk.mailer.SendRSVPChangedEmailToTripOwner(ctx, email.TripID, participantID)
+	case pgstore.EmailKindParticipantRemoved:
+		return wk.mailer.SendParticipantRemovedEmail(ctx, email.TripID, email.Email.String)
+	}
+
+	return fmt.Errorf("outbox: unknown email kind %q", email.Kind)
+}
+
+// requeueFailed queues an e-mail of kind for each participant of
+// deliveries whose e-mail failed, so they are tried again on their own
+// without e-mailing the others twice.
+func (wk Worker) requeueFailed(
+	ctx context.Context,
+	email pgstore.EmailOutbox,
+	kind pgstore.EmailKind,
+	deliveries []mailpit.Delivery,
+) error {
+	for _, d := range deliveries {
+		if d.Err == nil {
+			continue
+		}
+
+		wk.logger.Warn("failed to send email, queued again",
+			zap.String("email_id", email.ID.String()),
+			zap.String("trip_id", email.TripID.String()),
+			zap.String("participant_id", d.ParticipantID.String()),
+			zap.Error(d.Err),
+		)
+
+		params := pgstore.ParticipantEmail(kind, email.TripID, d.ParticipantID)
+		if err := wk.store.EnqueueEmail(ctx, params); err != nil {
+			return fmt.Errorf("outbox: failed to queue email again: %w", err)
+		}
+	}
+
+	return nil
+}
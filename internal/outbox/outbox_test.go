@@ -0,0 +1,33 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfigBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		attempts int32
+		want     time.Duration
+	}{
+		{"first attempt", DefaultConfig(), 1, 30 * time.Second},
+		{"before any attempt", DefaultConfig(), 0, 30 * time.Second},
+		{"second attempt", DefaultConfig(), 2, time.Minute},
+		{"third attempt", DefaultConfig(), 3, 2 * time.Minute},
+		{"seventh attempt", DefaultConfig(), 7, 32 * time.Minute},
+		{"capped", DefaultConfig(), 8, time.Hour},
+		{"many attempts", DefaultConfig(), 1000, time.Hour},
+		{"backoff above the cap", Config{Backoff: 2 * time.Hour, MaxBackoff: time.Hour}, 1, time.Hour},
+		{"uneven cap", Config{Backoff: time.Second, MaxBackoff: 5 * time.Second}, 4, 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.backoff(tt.attempts); got != tt.want {
+				t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+			}
+		})
+	}
+}
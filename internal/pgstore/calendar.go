@@ -0,0 +1,56 @@
+package pgstore
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// The iCalendar UIDs of trips and activities are made of a prefix, their
+// ID and a domain, so they stay the same in every calendar they are sent in.
+const (
+	tripUIDPrefix     = "trip-"
+	activityUIDPrefix = "activity-"
+	uidDomain         = "@journey"
+)
+
+// CalendarUID returns the UID of the calendar event spanning the trip.
+func (t Trip) CalendarUID() string {
+	return tripUIDPrefix + t.ID.String() + uidDomain
+}
+
+// CalendarUID returns the UID of the calendar event of the activity, or of
+// its occurrence on the given day, formatted as 20060102, when not empty.
+func (a Activity) CalendarUID(day string) string {
+	if day == "" {
+		return activityUIDPrefix + a.ID.String() + uidDomain
+	}
+	return activityUIDPrefix + a.ID.String() + "-" + day + uidDomain
+}
+
+// TripIDFromCalendarUID returns the ID of the trip whose event has uid.
+func TripIDFromCalendarUID(uid string) (uuid.UUID, bool) {
+	id, ok := strings.CutPrefix(uid, tripUIDPrefix)
+	if !ok {
+		return uuid.Nil, false
+	}
+	id, ok = strings.CutSuffix(id, uidDomain)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	tripID, err := uuid.Parse(id)
+	return tripID, err == nil
+}
+
+// ActivityIDFromCalendarUID returns the ID of the activity whose event, or
+// one of whose occurrences, has uid.
+func ActivityIDFromCalendarUID(uid string) (uuid.UUID, bool) {
+	id, ok := strings.CutPrefix(uid, activityUIDPrefix)
+	if !ok || len(id) < 36 || !strings.HasSuffix(id, uidDomain) {
+		return uuid.Nil, false
+	}
+
+	activityID, err := uuid.Parse(id[:36])
+	return activityID, err == nil
+}
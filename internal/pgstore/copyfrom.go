@@ -9,6 +9,55 @@ import (
 	"context"
 )
 
+// iteratorForInsertActivities implements pgx.CopyFromSource.
+type iteratorForInsertActivities struct {
+	rows                 []InsertActivitiesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertActivities) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertActivities) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].OccursAt,
+		r.rows[0].TimeZone,
+		r.rows[0].EndsAt,
+		r.rows[0].Location,
+		r.rows[0].Category,
+		r.rows[0].Address,
+		r.rows[0].Latitude,
+		r.rows[0].Longitude,
+		r.rows[0].BookingReference,
+		r.rows[0].CostAmount,
+		r.rows[0].CostCurrency,
+		r.rows[0].RecurrenceFrequency,
+		r.rows[0].RecurrenceInterval,
+		r.rows[0].RecurrenceWeekdays,
+		r.rows[0].RecurrenceUntil,
+	}, nil
+}
+
+func (r iteratorForInsertActivities) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertActivities(ctx context.Context, arg []InsertActivitiesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"activities"}, []string{"id", "trip_id", "title", "occurs_at", "time_zone", "ends_at", "location", "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency", "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"}, &iteratorForInsertActivities{rows: arg})
+}
+
 // iteratorForInviteParticipantsToTrip implements pgx.CopyFromSource.
 type iteratorForInviteParticipantsToTrip struct {
 	rows                 []InviteParticipantsToTripParams
@@ -0,0 +1,12 @@
+-- Write your migrate up statements here
+CREATE TYPE participant_role AS ENUM ('co_organizer', 'participant', 'viewer');
+
+ALTER TABLE participants
+    ADD COLUMN "role" participant_role NOT NULL DEFAULT 'participant';
+
+---- create above / drop below ----
+
+ALTER TABLE participants DROP COLUMN IF EXISTS "role";
+DROP TYPE IF EXISTS participant_role;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
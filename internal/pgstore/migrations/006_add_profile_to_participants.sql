@@ -0,0 +1,16 @@
+-- Write your migrate up statements here
+ALTER TABLE participants
+    ADD COLUMN "name"                   VARCHAR(255),
+    ADD COLUMN "phone"                  VARCHAR(32),
+    ADD COLUMN "dietary_notes"          TEXT,
+    ADD COLUMN "accessibility_notes"    TEXT;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "name",
+    DROP COLUMN IF EXISTS "phone",
+    DROP COLUMN IF EXISTS "dietary_notes",
+    DROP COLUMN IF EXISTS "accessibility_notes";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
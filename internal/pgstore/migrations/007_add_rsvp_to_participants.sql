@@ -0,0 +1,19 @@
+-- Write your migrate up statements here
+CREATE TYPE rsvp_status AS ENUM ('invited', 'accepted', 'declined', 'maybe', 'removed');
+
+ALTER TABLE participants
+    ADD COLUMN "rsvp_status"        rsvp_status     NOT NULL    DEFAULT 'invited',
+    ADD COLUMN "rsvp_note"          TEXT,
+    ADD COLUMN "rsvp_updated_at"    TIMESTAMP       NOT NULL    DEFAULT now();
+
+UPDATE participants SET "rsvp_status" = 'accepted' WHERE "is_confirmed";
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "rsvp_status",
+    DROP COLUMN IF EXISTS "rsvp_note",
+    DROP COLUMN IF EXISTS "rsvp_updated_at";
+DROP TYPE IF EXISTS rsvp_status;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,13 @@
+-- Write your migrate up statements here
+ALTER TABLE activities
+    ADD COLUMN "version"    INTEGER     NOT NULL    DEFAULT 1;
+
+ALTER TABLE links
+    ADD COLUMN "version"    INTEGER     NOT NULL    DEFAULT 1;
+
+---- create above / drop below ----
+
+ALTER TABLE activities DROP COLUMN IF EXISTS "version";
+ALTER TABLE links DROP COLUMN IF EXISTS "version";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
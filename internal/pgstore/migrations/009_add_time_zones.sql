@@ -0,0 +1,22 @@
+-- Write your migrate up statements here
+ALTER TABLE trips
+    ALTER COLUMN "starts_at"    TYPE TIMESTAMPTZ    USING "starts_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "ends_at"      TYPE TIMESTAMPTZ    USING "ends_at" AT TIME ZONE 'UTC',
+    ADD COLUMN "time_zone"      TEXT                NOT NULL    DEFAULT 'UTC';
+
+ALTER TABLE activities
+    ALTER COLUMN "occurs_at"    TYPE TIMESTAMPTZ    USING "occurs_at" AT TIME ZONE 'UTC',
+    ADD COLUMN "time_zone"      TEXT;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "time_zone",
+    ALTER COLUMN "occurs_at"    TYPE TIMESTAMP      USING "occurs_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "time_zone",
+    ALTER COLUMN "starts_at"    TYPE TIMESTAMP      USING "starts_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "ends_at"      TYPE TIMESTAMP      USING "ends_at" AT TIME ZONE 'UTC';
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,14 @@
+-- Write your migrate up statements here
+ALTER TABLE activities
+    ADD COLUMN "ends_at"    TIMESTAMPTZ,
+    ADD COLUMN "location"   VARCHAR(255),
+    ADD CONSTRAINT activities_ends_after_start CHECK ("ends_at" > "occurs_at");
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_ends_after_start,
+    DROP COLUMN IF EXISTS "ends_at",
+    DROP COLUMN IF EXISTS "location";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
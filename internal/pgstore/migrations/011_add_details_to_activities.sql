@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+CREATE TYPE activity_category AS ENUM ('transport', 'lodging', 'food', 'sightseeing', 'other');
+
+ALTER TABLE activities
+    ADD COLUMN "category"           activity_category,
+    ADD COLUMN "address"            TEXT,
+    ADD COLUMN "latitude"           DOUBLE PRECISION,
+    ADD COLUMN "longitude"          DOUBLE PRECISION,
+    ADD COLUMN "booking_reference"  VARCHAR(255),
+    ADD COLUMN "cost_amount"        NUMERIC(12, 2),
+    ADD COLUMN "cost_currency"      CHAR(3),
+    ADD CONSTRAINT activities_coordinates_check CHECK (
+        ("latitude" IS NULL) = ("longitude" IS NULL)
+        AND "latitude" BETWEEN -90 AND 90
+        AND "longitude" BETWEEN -180 AND 180
+    ),
+    ADD CONSTRAINT activities_cost_check CHECK (
+        ("cost_amount" IS NULL) = ("cost_currency" IS NULL)
+        AND "cost_amount" >= 0
+    );
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_coordinates_check,
+    DROP CONSTRAINT IF EXISTS activities_cost_check,
+    DROP COLUMN IF EXISTS "category",
+    DROP COLUMN IF EXISTS "address",
+    DROP COLUMN IF EXISTS "latitude",
+    DROP COLUMN IF EXISTS "longitude",
+    DROP COLUMN IF EXISTS "booking_reference",
+    DROP COLUMN IF EXISTS "cost_amount",
+    DROP COLUMN IF EXISTS "cost_currency";
+DROP TYPE IF EXISTS activity_category;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
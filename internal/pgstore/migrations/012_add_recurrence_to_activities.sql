@@ -0,0 +1,38 @@
+-- Write your migrate up statements here
+CREATE TYPE recurrence_frequency AS ENUM ('daily', 'weekly');
+
+ALTER TABLE activities
+    ADD COLUMN "recurrence_frequency"   recurrence_frequency,
+    ADD COLUMN "recurrence_interval"    INTEGER     NOT NULL    DEFAULT 1,
+    ADD COLUMN "recurrence_weekdays"    SMALLINT[]  NOT NULL    DEFAULT '{}',
+    ADD COLUMN "recurrence_until"       DATE,
+    ADD CONSTRAINT activities_recurrence_interval_check CHECK ("recurrence_interval" >= 1);
+
+CREATE TABLE IF NOT EXISTS activity_exceptions (
+    "activity_id"       uuid                        NOT NULL,
+    "occurrence_date"   DATE                        NOT NULL,
+    "is_cancelled"      BOOLEAN                     NOT NULL    DEFAULT FALSE,
+    "title"             VARCHAR(255),
+    "occurs_at"         TIMESTAMPTZ,
+    "ends_at"           TIMESTAMPTZ,
+    "location"          VARCHAR(255),
+
+    PRIMARY KEY (activity_id, occurrence_date),
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS activity_exceptions;
+
+ALTER TABLE activities
+    DROP CONSTRAINT IF EXISTS activities_recurrence_interval_check,
+    DROP COLUMN IF EXISTS "recurrence_frequency",
+    DROP COLUMN IF EXISTS "recurrence_interval",
+    DROP COLUMN IF EXISTS "recurrence_weekdays",
+    DROP COLUMN IF EXISTS "recurrence_until";
+DROP TYPE IF EXISTS recurrence_frequency;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,36 @@
+-- Write your migrate up statements here
+CREATE TYPE email_kind AS ENUM (
+    'trip_created', 'trip_confirmed', 'participant_invited',
+    'trip_updated', 'rsvp_changed', 'participant_removed'
+);
+CREATE TYPE email_status AS ENUM ('pending', 'sent', 'dead');
+
+CREATE TABLE IF NOT EXISTS email_outbox (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "kind"              email_kind                  NOT NULL,
+    "trip_id"           uuid                        NOT NULL,
+    "participant_id"    uuid,
+    "email"             VARCHAR(255),
+    "status"            email_status                NOT NULL    DEFAULT 'pending',
+    "attempts"          INTEGER                     NOT NULL    DEFAULT 0,
+    "last_error"        TEXT,
+    "next_attempt_at"   TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+    "created_at"        TIMESTAMPTZ                 NOT NULL    DEFAULT now(),
+    "sent_at"           TIMESTAMPTZ,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS email_outbox_pending_idx
+    ON email_outbox ("next_attempt_at")
+    WHERE "status" = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_outbox;
+DROP TYPE IF EXISTS email_status;
+DROP TYPE IF EXISTS email_kind;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
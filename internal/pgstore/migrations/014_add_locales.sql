@@ -0,0 +1,18 @@
+-- Write your migrate up statements here
+CREATE TYPE locale AS ENUM ('pt-BR', 'en');
+
+ALTER TABLE trips
+    ADD COLUMN "locale"     locale      NOT NULL    DEFAULT 'pt-BR';
+
+ALTER TABLE participants
+    ADD COLUMN "locale"     locale;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "locale";
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "locale";
+DROP TYPE IF EXISTS locale;
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
@@ -0,0 +1,9 @@
+-- Write your migrate up statements here
+ALTER TABLE activity_exceptions
+    ADD COLUMN "version"    INTEGER     NOT NULL    DEFAULT 1;
+
+---- create above / drop below ----
+
+ALTER TABLE activity_exceptions DROP COLUMN IF EXISTS "version";
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
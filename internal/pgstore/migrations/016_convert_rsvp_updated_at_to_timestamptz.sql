@@ -0,0 +1,10 @@
+-- Write your migrate up statements here
+ALTER TABLE participants
+    ALTER COLUMN "rsvp_updated_at"  TYPE TIMESTAMPTZ    USING "rsvp_updated_at" AT TIME ZONE 'UTC';
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    ALTER COLUMN "rsvp_updated_at"  TYPE TIMESTAMP      USING "rsvp_updated_at" AT TIME ZONE 'UTC';
+-- Write your migrate down statements here. If this migration is irreversible
+-- Then delete the separator line above.
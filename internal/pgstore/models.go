@@ -5,29 +5,388 @@
 package pgstore
 
 import (
+	"database/sql/driver"
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ActivityCategory string
+
+const (
+	ActivityCategoryTransport   ActivityCategory = "transport"
+	ActivityCategoryLodging     ActivityCategory = "lodging"
+	ActivityCategoryFood        ActivityCategory = "food"
+	ActivityCategorySightseeing ActivityCategory = "sightseeing"
+	ActivityCategoryOther       ActivityCategory = "other"
+)
+
+func (e *ActivityCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ActivityCategory(s)
+	case string:
+		*e = ActivityCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ActivityCategory: %T", src)
+	}
+	return nil
+}
+
+type NullActivityCategory struct {
+	ActivityCategory ActivityCategory
+	Valid            bool // Valid is true if ActivityCategory is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullActivityCategory) Scan(value interface{}) error {
+	if value == nil {
+		ns.ActivityCategory, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ActivityCategory.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullActivityCategory) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ActivityCategory), nil
+}
+
+type EmailKind string
+
+const (
+	EmailKindTripCreated        EmailKind = "trip_created"
+	EmailKindTripConfirmed      EmailKind = "trip_confirmed"
+	EmailKindParticipantInvited EmailKind = "participant_invited"
+	EmailKindTripUpdated        EmailKind = "trip_updated"
+	EmailKindRsvpChanged        EmailKind = "rsvp_changed"
+	EmailKindParticipantRemoved EmailKind = "participant_removed"
+)
+
+func (e *EmailKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailKind(s)
+	case string:
+		*e = EmailKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailKind: %T", src)
+	}
+	return nil
+}
+
+type NullEmailKind struct {
+	EmailKind EmailKind
+	Valid     bool // Valid is true if EmailKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailKind), nil
+}
+
+type EmailStatus string
+
+const (
+	EmailStatusPending EmailStatus = "pending"
+	EmailStatusSent    EmailStatus = "sent"
+	EmailStatusDead    EmailStatus = "dead"
+)
+
+func (e *EmailStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EmailStatus(s)
+	case string:
+		*e = EmailStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EmailStatus: %T", src)
+	}
+	return nil
+}
+
+type NullEmailStatus struct {
+	EmailStatus EmailStatus
+	Valid       bool // Valid is true if EmailStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEmailStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.EmailStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EmailStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEmailStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EmailStatus), nil
+}
+
+type Locale string
+
+const (
+	LocalePtBR Locale = "pt-BR"
+	LocaleEn   Locale = "en"
+)
+
+func (e *Locale) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = Locale(s)
+	case string:
+		*e = Locale(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Locale: %T", src)
+	}
+	return nil
+}
+
+type NullLocale struct {
+	Locale Locale
+	Valid  bool // Valid is true if Locale is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLocale) Scan(value interface{}) error {
+	if value == nil {
+		ns.Locale, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.Locale.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLocale) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.Locale), nil
+}
+
+type ParticipantRole string
+
+const (
+	ParticipantRoleCoOrganizer ParticipantRole = "co_organizer"
+	ParticipantRoleParticipant ParticipantRole = "participant"
+	ParticipantRoleViewer      ParticipantRole = "viewer"
+)
+
+func (e *ParticipantRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ParticipantRole(s)
+	case string:
+		*e = ParticipantRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ParticipantRole: %T", src)
+	}
+	return nil
+}
+
+type NullParticipantRole struct {
+	ParticipantRole ParticipantRole
+	Valid           bool // Valid is true if ParticipantRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullParticipantRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.ParticipantRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ParticipantRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullParticipantRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ParticipantRole), nil
+}
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily  RecurrenceFrequency = "daily"
+	RecurrenceFrequencyWeekly RecurrenceFrequency = "weekly"
+)
+
+func (e *RecurrenceFrequency) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RecurrenceFrequency(s)
+	case string:
+		*e = RecurrenceFrequency(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RecurrenceFrequency: %T", src)
+	}
+	return nil
+}
+
+type NullRecurrenceFrequency struct {
+	RecurrenceFrequency RecurrenceFrequency
+	Valid               bool // Valid is true if RecurrenceFrequency is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRecurrenceFrequency) Scan(value interface{}) error {
+	if value == nil {
+		ns.RecurrenceFrequency, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RecurrenceFrequency.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRecurrenceFrequency) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RecurrenceFrequency), nil
+}
+
+type RsvpStatus string
+
+const (
+	RsvpStatusInvited  RsvpStatus = "invited"
+	RsvpStatusAccepted RsvpStatus = "accepted"
+	RsvpStatusDeclined RsvpStatus = "declined"
+	RsvpStatusMaybe    RsvpStatus = "maybe"
+	RsvpStatusRemoved  RsvpStatus = "removed"
+)
+
+func (e *RsvpStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RsvpStatus(s)
+	case string:
+		*e = RsvpStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RsvpStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRsvpStatus struct {
+	RsvpStatus RsvpStatus
+	Valid      bool // Valid is true if RsvpStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRsvpStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RsvpStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RsvpStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRsvpStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RsvpStatus), nil
+}
+
 type Activity struct {
-	ID       uuid.UUID
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	ID                  uuid.UUID
+	TripID              uuid.UUID
+	Title               string
+	OccursAt            pgtype.Timestamptz
+	Version             int32
+	TimeZone            pgtype.Text
+	EndsAt              pgtype.Timestamptz
+	Location            pgtype.Text
+	Category            NullActivityCategory
+	Address             pgtype.Text
+	Latitude            pgtype.Float8
+	Longitude           pgtype.Float8
+	BookingReference    pgtype.Text
+	CostAmount          pgtype.Numeric
+	CostCurrency        pgtype.Text
+	RecurrenceFrequency NullRecurrenceFrequency
+	RecurrenceInterval  int32
+	RecurrenceWeekdays  []int16
+	RecurrenceUntil     pgtype.Date
+}
+
+type ActivityException struct {
+	ActivityID     uuid.UUID
+	OccurrenceDate pgtype.Date
+	IsCancelled    bool
+	Title          pgtype.Text
+	OccursAt       pgtype.Timestamptz
+	EndsAt         pgtype.Timestamptz
+	Location       pgtype.Text
+	Version        int32
+}
+
+type EmailOutbox struct {
+	ID            uuid.UUID
+	Kind          EmailKind
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+	Email         pgtype.Text
+	Status        EmailStatus
+	Attempts      int32
+	LastError     pgtype.Text
+	NextAttemptAt pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	SentAt        pgtype.Timestamptz
 }
 
 type Link struct {
-	ID     uuid.UUID
-	TripID uuid.UUID
-	Title  string
-	Url    string
+	ID      uuid.UUID
+	TripID  uuid.UUID
+	Title   string
+	Url     string
+	Version int32
 }
 
 type Participant struct {
-	ID          uuid.UUID
-	TripID      uuid.UUID
-	Email       string
-	IsConfirmed bool
+	ID                 uuid.UUID
+	TripID             uuid.UUID
+	Email              string
+	IsConfirmed        bool
+	Role               ParticipantRole
+	Name               pgtype.Text
+	Phone              pgtype.Text
+	DietaryNotes       pgtype.Text
+	AccessibilityNotes pgtype.Text
+	RsvpStatus         RsvpStatus
+	RsvpNote           pgtype.Text
+	RsvpUpdatedAt      pgtype.Timestamptz
+	Locale             NullLocale
 }
 
 type Trip struct {
@@ -36,6 +395,8 @@ type Trip struct {
 	OwnerEmail  string
 	OwnerName   string
 	IsConfirmed bool
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+	StartsAt    pgtype.Timestamptz
+	EndsAt      pgtype.Timestamptz
+	TimeZone    string
+	Locale      Locale
 }
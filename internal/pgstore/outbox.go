@@ -0,0 +1,31 @@
+package pgstore
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TripEmail queues an e-mail of kind about tripID.
+func TripEmail(kind EmailKind, tripID uuid.UUID) EnqueueEmailParams {
+	return EnqueueEmailParams{Kind: kind, TripID: tripID}
+}
+
+// ParticipantEmail queues an e-mail of kind about participantID of tripID.
+func ParticipantEmail(kind EmailKind, tripID, participantID uuid.UUID) EnqueueEmailParams {
+	return EnqueueEmailParams{
+		Kind:          kind,
+		TripID:        tripID,
+		ParticipantID: pgtype.UUID{Bytes: participantID, Valid: true},
+	}
+}
+
+// ParticipantRemovedEmail queues the e-mail letting email know they were
+// removed from tripID. The address is kept since the participant is gone
+// by the time it is sent.
+func ParticipantRemovedEmail(tripID uuid.UUID, email string) EnqueueEmailParams {
+	return EnqueueEmailParams{
+		Kind:   EmailKindParticipantRemoved,
+		TripID: tripID,
+		Email:  pgtype.Text{String: email, Valid: true},
+	}
+}
@@ -12,9 +12,71 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimEmails = `-- name: ClaimEmails :many
+UPDATE email_outbox
+SET
+    "attempts" = "attempts" + 1,
+    "next_attempt_at" = $1
+WHERE
+    id IN (
+        SELECT id
+        FROM email_outbox
+        WHERE
+            "status" = 'pending'
+            AND "next_attempt_at" <= now()
+        ORDER BY "next_attempt_at", "id"
+        LIMIT $2
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING
+    "id", "kind", "trip_id", "participant_id", "email", "status", "attempts",
+    "last_error", "next_attempt_at", "created_at", "sent_at"
+`
+
+type ClaimEmailsParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+func (q *Queries) ClaimEmails(ctx context.Context, arg ClaimEmailsParams) ([]EmailOutbox, error) {
+	rows, err := q.db.Query(ctx, claimEmails, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.TripID,
+			&i.ParticipantID,
+			&i.Email,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.NextAttemptAt,
+			&i.CreatedAt,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const confirmParticipant = `-- name: ConfirmParticipant :exec
 UPDATE participants
-SET "is_confirmed" = true
+SET
+    "is_confirmed" = true,
+    "rsvp_status" = 'accepted',
+    "rsvp_note" = NULL,
+    "rsvp_updated_at" = now()
 WHERE id = $1
 `
 
@@ -23,21 +85,96 @@ func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const confirmTrip = `-- name: ConfirmTrip :execrows
+UPDATE trips
+SET "is_confirmed" = true
+WHERE
+    id = $1
+    AND "is_confirmed" = false
+`
+
+func (q *Queries) ConfirmTrip(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countActivitiesOutsideRange = `-- name: CountActivitiesOutsideRange :one
+SELECT
+    COUNT(*)
+FROM activities
+WHERE
+    trip_id = $1
+    AND recurrence_frequency IS NULL
+    AND ( occurs_at < $2 OR occurs_at > $3 )
+`
+
+type CountActivitiesOutsideRangeParams struct {
+	TripID   uuid.UUID
+	StartsAt pgtype.Timestamptz
+	EndsAt   pgtype.Timestamptz
+}
+
+func (q *Queries) CountActivitiesOutsideRange(ctx context.Context, arg CountActivitiesOutsideRangeParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countActivitiesOutsideRange, arg.TripID, arg.StartsAt, arg.EndsAt)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createActivity = `-- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    (
+        "trip_id", "title", "occurs_at", "time_zone", "ends_at", "location",
+        "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+        "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+    ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17 )
 RETURNING "id"
 `
 
 type CreateActivityParams struct {
-	TripID   uuid.UUID
-	Title    string
-	OccursAt pgtype.Timestamp
+	TripID              uuid.UUID
+	Title               string
+	OccursAt            pgtype.Timestamptz
+	TimeZone            pgtype.Text
+	EndsAt              pgtype.Timestamptz
+	Location            pgtype.Text
+	Category            NullActivityCategory
+	Address             pgtype.Text
+	Latitude            pgtype.Float8
+	Longitude           pgtype.Float8
+	BookingReference    pgtype.Text
+	CostAmount          pgtype.Numeric
+	CostCurrency        pgtype.Text
+	RecurrenceFrequency NullRecurrenceFrequency
+	RecurrenceInterval  int32
+	RecurrenceWeekdays  []int16
+	RecurrenceUntil     pgtype.Date
 }
 
 func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.TimeZone,
+		arg.EndsAt,
+		arg.Location,
+		arg.Category,
+		arg.Address,
+		arg.Latitude,
+		arg.Longitude,
+		arg.BookingReference,
+		arg.CostAmount,
+		arg.CostCurrency,
+		arg.RecurrenceFrequency,
+		arg.RecurrenceInterval,
+		arg.RecurrenceWeekdays,
+		arg.RecurrenceUntil,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -63,9 +200,167 @@ func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams)
 	return id, err
 }
 
+const deleteParticipant = `-- name: DeleteParticipant :exec
+DELETE FROM participants
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteParticipant(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteParticipant, id)
+	return err
+}
+
+const deleteTrip = `-- name: DeleteTrip :execrows
+DELETE FROM trips
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteTrip(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripActivity = `-- name: DeleteTripActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteTripActivityParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteTripActivity(ctx context.Context, arg DeleteTripActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripLink = `-- name: DeleteTripLink :execrows
+DELETE FROM links
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteTripLinkParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) DeleteTripLink(ctx context.Context, arg DeleteTripLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripLink, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueEmail = `-- name: EnqueueEmail :exec
+INSERT INTO email_outbox
+    ( "kind", "trip_id", "participant_id", "email" ) VALUES
+    ( $1, $2, $3, $4 )
+`
+
+type EnqueueEmailParams struct {
+	Kind          EmailKind
+	TripID        uuid.UUID
+	ParticipantID pgtype.UUID
+	Email         pgtype.Text
+}
+
+func (q *Queries) EnqueueEmail(ctx context.Context, arg EnqueueEmailParams) error {
+	_, err := q.db.Exec(ctx, enqueueEmail,
+		arg.Kind,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Email,
+	)
+	return err
+}
+
+const getOverlappingActivities = `-- name: GetOverlappingActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+FROM activities
+WHERE
+    trip_id = $1
+    AND id <> $2
+    AND (
+        recurrence_frequency IS NOT NULL
+        OR ( occurs_at <= $3 AND $4 <= COALESCE(ends_at, occurs_at) )
+    )
+ORDER BY "occurs_at", "id"
+`
+
+type GetOverlappingActivitiesParams struct {
+	TripID    uuid.UUID
+	ExcludeID uuid.UUID
+	EndsAt    pgtype.Timestamptz
+	OccursAt  pgtype.Timestamptz
+}
+
+func (q *Queries) GetOverlappingActivities(ctx context.Context, arg GetOverlappingActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getOverlappingActivities,
+		arg.TripID,
+		arg.ExcludeID,
+		arg.EndsAt,
+		arg.OccursAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Version,
+			&i.TimeZone,
+			&i.EndsAt,
+			&i.Location,
+			&i.Category,
+			&i.Address,
+			&i.Latitude,
+			&i.Longitude,
+			&i.BookingReference,
+			&i.CostAmount,
+			&i.CostCurrency,
+			&i.RecurrenceFrequency,
+			&i.RecurrenceInterval,
+			&i.RecurrenceWeekdays,
+			&i.RecurrenceUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getParticipant = `-- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
 FROM participants
 WHERE
     id = $1
@@ -79,13 +374,63 @@ func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant
 		&i.TripID,
 		&i.Email,
 		&i.IsConfirmed,
+		&i.Role,
+		&i.Name,
+		&i.Phone,
+		&i.DietaryNotes,
+		&i.AccessibilityNotes,
+		&i.RsvpStatus,
+		&i.RsvpNote,
+		&i.RsvpUpdatedAt,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const getParticipantByEmail = `-- name: GetParticipantByEmail :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
+FROM participants
+WHERE
+    trip_id = $1
+    AND lower(email) = lower($2)
+ORDER BY "id"
+LIMIT 1
+`
+
+type GetParticipantByEmailParams struct {
+	TripID uuid.UUID
+	Email  string
+}
+
+func (q *Queries) GetParticipantByEmail(ctx context.Context, arg GetParticipantByEmailParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipantByEmail, arg.TripID, arg.Email)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.Role,
+		&i.Name,
+		&i.Phone,
+		&i.DietaryNotes,
+		&i.AccessibilityNotes,
+		&i.RsvpStatus,
+		&i.RsvpNote,
+		&i.RsvpUpdatedAt,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getParticipants = `-- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
 FROM participants
 WHERE
     trip_id = $1
@@ -105,6 +450,65 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 			&i.TripID,
 			&i.Email,
 			&i.IsConfirmed,
+			&i.Role,
+			&i.Name,
+			&i.Phone,
+			&i.DietaryNotes,
+			&i.AccessibilityNotes,
+			&i.RsvpStatus,
+			&i.RsvpNote,
+			&i.RsvpUpdatedAt,
+			&i.Locale,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipantsByRSVP = `-- name: GetParticipantsByRSVP :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
+FROM participants
+WHERE
+    trip_id = $1
+    AND rsvp_status::text = ANY( $2::text[] )
+`
+
+type GetParticipantsByRSVPParams struct {
+	TripID   uuid.UUID
+	Statuses []string
+}
+
+func (q *Queries) GetParticipantsByRSVP(ctx context.Context, arg GetParticipantsByRSVPParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipantsByRSVP, arg.TripID, arg.Statuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Role,
+			&i.Name,
+			&i.Phone,
+			&i.DietaryNotes,
+			&i.AccessibilityNotes,
+			&i.RsvpStatus,
+			&i.RsvpNote,
+			&i.RsvpUpdatedAt,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -118,7 +522,7 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 
 const getTrip = `-- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "time_zone", "locale"
 FROM trips
 WHERE
     id = $1
@@ -135,20 +539,40 @@ func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
 		&i.IsConfirmed,
 		&i.StartsAt,
 		&i.EndsAt,
+		&i.TimeZone,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getTripActivities = `-- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
 FROM activities
 WHERE
     trip_id = $1
+    AND ( $2::text[] IS NULL OR category::text = ANY( $2::text[] ) )
+    AND ( $3::timestamptz IS NULL OR occurs_at >= $3 OR recurrence_frequency IS NOT NULL )
+    AND ( $4::timestamptz IS NULL OR occurs_at < $4 )
+ORDER BY "occurs_at", "id"
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+type GetTripActivitiesParams struct {
+	TripID      uuid.UUID
+	Categories  []string
+	OccursFrom  pgtype.Timestamptz
+	OccursUntil pgtype.Timestamptz
+}
+
+func (q *Queries) GetTripActivities(ctx context.Context, arg GetTripActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities,
+		arg.TripID,
+		arg.Categories,
+		arg.OccursFrom,
+		arg.OccursUntil,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +585,103 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 			&i.TripID,
 			&i.Title,
 			&i.OccursAt,
+			&i.Version,
+			&i.TimeZone,
+			&i.EndsAt,
+			&i.Location,
+			&i.Category,
+			&i.Address,
+			&i.Latitude,
+			&i.Longitude,
+			&i.BookingReference,
+			&i.CostAmount,
+			&i.CostCurrency,
+			&i.RecurrenceFrequency,
+			&i.RecurrenceInterval,
+			&i.RecurrenceWeekdays,
+			&i.RecurrenceUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivity = `-- name: GetTripActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type GetTripActivityParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetTripActivity(ctx context.Context, arg GetTripActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, getTripActivity, arg.ID, arg.TripID)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.Version,
+		&i.TimeZone,
+		&i.EndsAt,
+		&i.Location,
+		&i.Category,
+		&i.Address,
+		&i.Latitude,
+		&i.Longitude,
+		&i.BookingReference,
+		&i.CostAmount,
+		&i.CostCurrency,
+		&i.RecurrenceFrequency,
+		&i.RecurrenceInterval,
+		&i.RecurrenceWeekdays,
+		&i.RecurrenceUntil,
+	)
+	return i, err
+}
+
+const getTripActivityExceptions = `-- name: GetTripActivityExceptions :many
+SELECT
+    e."activity_id", e."occurrence_date", e."is_cancelled", e."title", e."occurs_at", e."ends_at", e."location",
+    e."version"
+FROM activity_exceptions e
+JOIN activities a ON a.id = e.activity_id
+WHERE
+    a.trip_id = $1
+`
+
+func (q *Queries) GetTripActivityExceptions(ctx context.Context, tripID uuid.UUID) ([]ActivityException, error) {
+	rows, err := q.db.Query(ctx, getTripActivityExceptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityException
+	for rows.Next() {
+		var i ActivityException
+		if err := rows.Scan(
+			&i.ActivityID,
+			&i.OccurrenceDate,
+			&i.IsCancelled,
+			&i.Title,
+			&i.OccursAt,
+			&i.EndsAt,
+			&i.Location,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -172,9 +693,89 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 	return items, nil
 }
 
+const getTripCounts = `-- name: GetTripCounts :one
+SELECT
+    ( SELECT COUNT(*) FROM participants p WHERE p.trip_id = $1 ) AS "participants_count",
+    ( SELECT COUNT(*) FROM participants p WHERE p.trip_id = $1 AND p.is_confirmed ) AS "confirmed_participants_count",
+    ( SELECT COUNT(*) FROM activities a WHERE a.trip_id = $1 ) AS "activities_count",
+    ( SELECT COUNT(*) FROM links l WHERE l.trip_id = $1 ) AS "links_count"
+`
+
+type GetTripCountsRow struct {
+	ParticipantsCount          int64
+	ConfirmedParticipantsCount int64
+	ActivitiesCount            int64
+	LinksCount                 int64
+}
+
+func (q *Queries) GetTripCounts(ctx context.Context, tripID uuid.UUID) (GetTripCountsRow, error) {
+	row := q.db.QueryRow(ctx, getTripCounts, tripID)
+	var i GetTripCountsRow
+	err := row.Scan(
+		&i.ParticipantsCount,
+		&i.ConfirmedParticipantsCount,
+		&i.ActivitiesCount,
+		&i.LinksCount,
+	)
+	return i, err
+}
+
+const getTripForUpdate = `-- name: GetTripForUpdate :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "time_zone", "locale"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetTripForUpdate(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTripForUpdate, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.IsConfirmed,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.TimeZone,
+		&i.Locale,
+	)
+	return i, err
+}
+
+const getTripLink = `-- name: GetTripLink :one
+SELECT
+    "id", "trip_id", "title", "url", "version"
+FROM links
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type GetTripLinkParams struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+}
+
+func (q *Queries) GetTripLink(ctx context.Context, arg GetTripLinkParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getTripLink, arg.ID, arg.TripID)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Url,
+		&i.Version,
+	)
+	return i, err
+}
+
 const getTripLinks = `-- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "version"
 FROM links
 WHERE
     trip_id = $1
@@ -194,6 +795,7 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 			&i.TripID,
 			&i.Title,
 			&i.Url,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -205,10 +807,31 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 	return items, nil
 }
 
+type InsertActivitiesParams struct {
+	ID                  uuid.UUID
+	TripID              uuid.UUID
+	Title               string
+	OccursAt            pgtype.Timestamptz
+	TimeZone            pgtype.Text
+	EndsAt              pgtype.Timestamptz
+	Location            pgtype.Text
+	Category            NullActivityCategory
+	Address             pgtype.Text
+	Latitude            pgtype.Float8
+	Longitude           pgtype.Float8
+	BookingReference    pgtype.Text
+	CostAmount          pgtype.Numeric
+	CostCurrency        pgtype.Text
+	RecurrenceFrequency NullRecurrenceFrequency
+	RecurrenceInterval  int32
+	RecurrenceWeekdays  []int16
+	RecurrenceUntil     pgtype.Date
+}
+
 const insertTrip = `-- name: InsertTrip :one
 INSERT INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at" ) VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "time_zone", "locale" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id"
 `
 
@@ -216,8 +839,10 @@ type InsertTripParams struct {
 	Destination string
 	OwnerEmail  string
 	OwnerName   string
-	StartsAt    pgtype.Timestamp
-	EndsAt      pgtype.Timestamp
+	StartsAt    pgtype.Timestamptz
+	EndsAt      pgtype.Timestamptz
+	TimeZone    string
+	Locale      Locale
 }
 
 func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
@@ -227,6 +852,8 @@ func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UU
 		arg.OwnerName,
 		arg.StartsAt,
 		arg.EndsAt,
+		arg.TimeZone,
+		arg.Locale,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -257,22 +884,137 @@ type InviteParticipantsToTripParams struct {
 	Email  string
 }
 
+const markEmailFailed = `-- name: MarkEmailFailed :exec
+UPDATE email_outbox
+SET
+    "status" = $1,
+    "last_error" = $2,
+    "next_attempt_at" = $3
+WHERE
+    id = $4
+`
+
+type MarkEmailFailedParams struct {
+	Status        EmailStatus
+	LastError     pgtype.Text
+	NextAttemptAt pgtype.Timestamptz
+	ID            uuid.UUID
+}
+
+func (q *Queries) MarkEmailFailed(ctx context.Context, arg MarkEmailFailedParams) error {
+	_, err := q.db.Exec(ctx, markEmailFailed,
+		arg.Status,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const markEmailSent = `-- name: MarkEmailSent :exec
+UPDATE email_outbox
+SET
+    "status" = 'sent',
+    "last_error" = NULL,
+    "sent_at" = now()
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkEmailSent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markEmailSent, id)
+	return err
+}
+
+const setParticipantRSVP = `-- name: SetParticipantRSVP :exec
+UPDATE participants
+SET
+    "is_confirmed" = ( $1::rsvp_status = 'accepted' ),
+    "rsvp_status" = $1,
+    "rsvp_note" = $2,
+    "rsvp_updated_at" = now()
+WHERE id = $3
+`
+
+type SetParticipantRSVPParams struct {
+	RsvpStatus RsvpStatus
+	RsvpNote   pgtype.Text
+	ID         uuid.UUID
+}
+
+func (q *Queries) SetParticipantRSVP(ctx context.Context, arg SetParticipantRSVPParams) error {
+	_, err := q.db.Exec(ctx, setParticipantRSVP, arg.RsvpStatus, arg.RsvpNote, arg.ID)
+	return err
+}
+
+const updateParticipantProfile = `-- name: UpdateParticipantProfile :exec
+UPDATE participants
+SET
+    "name" = $2,
+    "phone" = $3,
+    "dietary_notes" = $4,
+    "accessibility_notes" = $5,
+    "locale" = $6
+WHERE id = $1
+`
+
+type UpdateParticipantProfileParams struct {
+	ID                 uuid.UUID
+	Name               pgtype.Text
+	Phone              pgtype.Text
+	DietaryNotes       pgtype.Text
+	AccessibilityNotes pgtype.Text
+	Locale             NullLocale
+}
+
+func (q *Queries) UpdateParticipantProfile(ctx context.Context, arg UpdateParticipantProfileParams) error {
+	_, err := q.db.Exec(ctx, updateParticipantProfile,
+		arg.ID,
+		arg.Name,
+		arg.Phone,
+		arg.DietaryNotes,
+		arg.AccessibilityNotes,
+		arg.Locale,
+	)
+	return err
+}
+
+const updateParticipantRole = `-- name: UpdateParticipantRole :exec
+UPDATE participants
+SET "role" = $2
+WHERE id = $1
+`
+
+type UpdateParticipantRoleParams struct {
+	ID   uuid.UUID
+	Role ParticipantRole
+}
+
+func (q *Queries) UpdateParticipantRole(ctx context.Context, arg UpdateParticipantRoleParams) error {
+	_, err := q.db.Exec(ctx, updateParticipantRole, arg.ID, arg.Role)
+	return err
+}
+
 const updateTrip = `-- name: UpdateTrip :exec
 UPDATE trips
 SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "time_zone" = $5,
+    "locale" = $6
 WHERE
-    id = $5
+    id = $7
 `
 
 type UpdateTripParams struct {
 	Destination string
-	EndsAt      pgtype.Timestamp
-	StartsAt    pgtype.Timestamp
+	EndsAt      pgtype.Timestamptz
+	StartsAt    pgtype.Timestamptz
 	IsConfirmed bool
+	TimeZone    string
+	Locale      Locale
 	ID          uuid.UUID
 }
 
@@ -282,7 +1024,185 @@ func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
 		arg.EndsAt,
 		arg.StartsAt,
 		arg.IsConfirmed,
+		arg.TimeZone,
+		arg.Locale,
 		arg.ID,
 	)
 	return err
 }
+
+const updateTripActivity = `-- name: UpdateTripActivity :one
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "time_zone" = $3,
+    "ends_at" = $4,
+    "location" = $5,
+    "category" = $6,
+    "address" = $7,
+    "latitude" = $8,
+    "longitude" = $9,
+    "booking_reference" = $10,
+    "cost_amount" = $11,
+    "cost_currency" = $12,
+    "recurrence_frequency" = $13,
+    "recurrence_interval" = $14,
+    "recurrence_weekdays" = $15,
+    "recurrence_until" = $16,
+    "version" = "version" + 1
+WHERE
+    id = $17
+    AND trip_id = $18
+    AND "version" = $19
+RETURNING
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+`
+
+type UpdateTripActivityParams struct {
+	Title               string
+	OccursAt            pgtype.Timestamptz
+	TimeZone            pgtype.Text
+	EndsAt              pgtype.Timestamptz
+	Location            pgtype.Text
+	Category            NullActivityCategory
+	Address             pgtype.Text
+	Latitude            pgtype.Float8
+	Longitude           pgtype.Float8
+	BookingReference    pgtype.Text
+	CostAmount          pgtype.Numeric
+	CostCurrency        pgtype.Text
+	RecurrenceFrequency NullRecurrenceFrequency
+	RecurrenceInterval  int32
+	RecurrenceWeekdays  []int16
+	RecurrenceUntil     pgtype.Date
+	ID                  uuid.UUID
+	TripID              uuid.UUID
+	Version             int32
+}
+
+func (q *Queries) UpdateTripActivity(ctx context.Context, arg UpdateTripActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, updateTripActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.TimeZone,
+		arg.EndsAt,
+		arg.Location,
+		arg.Category,
+		arg.Address,
+		arg.Latitude,
+		arg.Longitude,
+		arg.BookingReference,
+		arg.CostAmount,
+		arg.CostCurrency,
+		arg.RecurrenceFrequency,
+		arg.RecurrenceInterval,
+		arg.RecurrenceWeekdays,
+		arg.RecurrenceUntil,
+		arg.ID,
+		arg.TripID,
+		arg.Version,
+	)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.Version,
+		&i.TimeZone,
+		&i.EndsAt,
+		&i.Location,
+		&i.Category,
+		&i.Address,
+		&i.Latitude,
+		&i.Longitude,
+		&i.BookingReference,
+		&i.CostAmount,
+		&i.CostCurrency,
+		&i.RecurrenceFrequency,
+		&i.RecurrenceInterval,
+		&i.RecurrenceWeekdays,
+		&i.RecurrenceUntil,
+	)
+	return i, err
+}
+
+const updateTripLink = `-- name: UpdateTripLink :one
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "version" = "version" + 1
+WHERE
+    id = $3
+    AND trip_id = $4
+    AND "version" = $5
+RETURNING "id", "trip_id", "title", "url", "version"
+`
+
+type UpdateTripLinkParams struct {
+	Title   string
+	Url     string
+	ID      uuid.UUID
+	TripID  uuid.UUID
+	Version int32
+}
+
+func (q *Queries) UpdateTripLink(ctx context.Context, arg UpdateTripLinkParams) (Link, error) {
+	row := q.db.QueryRow(ctx, updateTripLink,
+		arg.Title,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+		arg.Version,
+	)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Url,
+		&i.Version,
+	)
+	return i, err
+}
+
+const upsertActivityException = `-- name: UpsertActivityException :exec
+INSERT INTO activity_exceptions
+    ( "activity_id", "occurrence_date", "is_cancelled", "title", "occurs_at", "ends_at", "location" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+ON CONFLICT ( "activity_id", "occurrence_date" ) DO UPDATE
+SET
+    "is_cancelled" = EXCLUDED."is_cancelled",
+    "title" = EXCLUDED."title",
+    "occurs_at" = EXCLUDED."occurs_at",
+    "ends_at" = EXCLUDED."ends_at",
+    "location" = EXCLUDED."location",
+    "version" = activity_exceptions."version" + 1
+`
+
+type UpsertActivityExceptionParams struct {
+	ActivityID     uuid.UUID
+	OccurrenceDate pgtype.Date
+	IsCancelled    bool
+	Title          pgtype.Text
+	OccursAt       pgtype.Timestamptz
+	EndsAt         pgtype.Timestamptz
+	Location       pgtype.Text
+}
+
+func (q *Queries) UpsertActivityException(ctx context.Context, arg UpsertActivityExceptionParams) error {
+	_, err := q.db.Exec(ctx, upsertActivityException,
+		arg.ActivityID,
+		arg.OccurrenceDate,
+		arg.IsCancelled,
+		arg.Title,
+		arg.OccursAt,
+		arg.EndsAt,
+		arg.Location,
+	)
+	return err
+}
@@ -1,45 +1,133 @@
 -- name: InsertTrip :one
 INSERT INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at" ) VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "time_zone", "locale" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
 RETURNING "id";
 
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "time_zone", "locale"
 FROM trips
 WHERE
     id = $1;
 
+-- name: GetTripForUpdate :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "time_zone", "locale"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: GetTripCounts :one
+SELECT
+    ( SELECT COUNT(*) FROM participants p WHERE p.trip_id = $1 ) AS "participants_count",
+    ( SELECT COUNT(*) FROM participants p WHERE p.trip_id = $1 AND p.is_confirmed ) AS "confirmed_participants_count",
+    ( SELECT COUNT(*) FROM activities a WHERE a.trip_id = $1 ) AS "activities_count",
+    ( SELECT COUNT(*) FROM links l WHERE l.trip_id = $1 ) AS "links_count";
+
 -- name: UpdateTrip :exec
 UPDATE trips
 SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "time_zone" = $5,
+    "locale" = $6
 WHERE
-    id = $5;
+    id = $7;
+
+-- name: ConfirmTrip :execrows
+UPDATE trips
+SET "is_confirmed" = true
+WHERE
+    id = $1
+    AND "is_confirmed" = false;
+
+-- name: DeleteTrip :execrows
+DELETE FROM trips
+WHERE
+    id = $1;
 
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
 FROM participants
 WHERE
     id = $1;
 
+-- name: GetParticipantByEmail :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
+FROM participants
+WHERE
+    trip_id = $1
+    AND lower(email) = lower(sqlc.arg(email))
+ORDER BY "id"
+LIMIT 1;
+
 -- name: ConfirmParticipant :exec
 UPDATE participants
-SET "is_confirmed" = true
+SET
+    "is_confirmed" = true,
+    "rsvp_status" = 'accepted',
+    "rsvp_note" = NULL,
+    "rsvp_updated_at" = now()
 WHERE id = $1;
 
+-- name: SetParticipantRSVP :exec
+UPDATE participants
+SET
+    "is_confirmed" = ( sqlc.arg(rsvp_status)::rsvp_status = 'accepted' ),
+    "rsvp_status" = sqlc.arg(rsvp_status),
+    "rsvp_note" = sqlc.arg(rsvp_note),
+    "rsvp_updated_at" = now()
+WHERE id = sqlc.arg(id);
+
+-- name: UpdateParticipantRole :exec
+UPDATE participants
+SET "role" = $2
+WHERE id = $1;
+
+-- name: UpdateParticipantProfile :exec
+UPDATE participants
+SET
+    "name" = $2,
+    "phone" = $3,
+    "dietary_notes" = $4,
+    "accessibility_notes" = $5,
+    "locale" = $6
+WHERE id = $1;
+
+-- name: DeleteParticipant :exec
+DELETE FROM participants
+WHERE
+    id = $1;
+
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
 FROM participants
 WHERE
     trip_id = $1;
 
+-- name: GetParticipantsByRSVP :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "role",
+    "name", "phone", "dietary_notes", "accessibility_notes",
+    "rsvp_status", "rsvp_note", "rsvp_updated_at", "locale"
+FROM participants
+WHERE
+    trip_id = $1
+    AND rsvp_status::text = ANY( sqlc.arg(statuses)::text[] );
+
 -- name: InviteParticipantToTrip :one
 INSERT INTO participants
     ( "trip_id", "email" ) VALUES
@@ -53,16 +141,126 @@ INSERT INTO participants
 
 -- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    (
+        "trip_id", "title", "occurs_at", "time_zone", "ends_at", "location",
+        "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+        "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+    ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17 )
 RETURNING "id";
 
+-- name: InsertActivities :copyfrom
+INSERT INTO activities
+    (
+        "id", "trip_id", "title", "occurs_at", "time_zone", "ends_at", "location",
+        "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+        "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+    ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18 );
+
+-- name: CountActivitiesOutsideRange :one
+SELECT
+    COUNT(*)
+FROM activities
+WHERE
+    trip_id = $1
+    AND recurrence_frequency IS NULL
+    AND ( occurs_at < sqlc.arg(starts_at) OR occurs_at > sqlc.arg(ends_at) );
+
 -- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
 FROM activities
 WHERE
-    trip_id = $1;
+    trip_id = sqlc.arg(trip_id)
+    AND ( sqlc.narg(categories)::text[] IS NULL OR category::text = ANY( sqlc.narg(categories)::text[] ) )
+    AND ( sqlc.narg(occurs_from)::timestamptz IS NULL OR occurs_at >= sqlc.narg(occurs_from) OR recurrence_frequency IS NOT NULL )
+    AND ( sqlc.narg(occurs_until)::timestamptz IS NULL OR occurs_at < sqlc.narg(occurs_until) )
+ORDER BY "occurs_at", "id";
+
+-- name: GetOverlappingActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+FROM activities
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND id <> sqlc.arg(exclude_id)
+    AND (
+        recurrence_frequency IS NOT NULL
+        OR ( occurs_at <= sqlc.arg(ends_at) AND sqlc.arg(occurs_at) <= COALESCE(ends_at, occurs_at) )
+    )
+ORDER BY "occurs_at", "id";
+
+-- name: GetTripActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until"
+FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: UpdateTripActivity :one
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "time_zone" = $3,
+    "ends_at" = $4,
+    "location" = $5,
+    "category" = $6,
+    "address" = $7,
+    "latitude" = $8,
+    "longitude" = $9,
+    "booking_reference" = $10,
+    "cost_amount" = $11,
+    "cost_currency" = $12,
+    "recurrence_frequency" = $13,
+    "recurrence_interval" = $14,
+    "recurrence_weekdays" = $15,
+    "recurrence_until" = $16,
+    "version" = "version" + 1
+WHERE
+    id = $17
+    AND trip_id = $18
+    AND "version" = $19
+RETURNING
+    "id", "trip_id", "title", "occurs_at", "version", "time_zone", "ends_at", "location",
+    "category", "address", "latitude", "longitude", "booking_reference", "cost_amount", "cost_currency",
+    "recurrence_frequency", "recurrence_interval", "recurrence_weekdays", "recurrence_until";
+
+-- name: DeleteTripActivity :execrows
+DELETE FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: GetTripActivityExceptions :many
+SELECT
+    e."activity_id", e."occurrence_date", e."is_cancelled", e."title", e."occurs_at", e."ends_at", e."location",
+    e."version"
+FROM activity_exceptions e
+JOIN activities a ON a.id = e.activity_id
+WHERE
+    a.trip_id = $1;
+
+-- name: UpsertActivityException :exec
+INSERT INTO activity_exceptions
+    ( "activity_id", "occurrence_date", "is_cancelled", "title", "occurs_at", "ends_at", "location" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+ON CONFLICT ( "activity_id", "occurrence_date" ) DO UPDATE
+SET
+    "is_cancelled" = EXCLUDED."is_cancelled",
+    "title" = EXCLUDED."title",
+    "occurs_at" = EXCLUDED."occurs_at",
+    "ends_at" = EXCLUDED."ends_at",
+    "location" = EXCLUDED."location",
+    "version" = activity_exceptions."version" + 1;
 
 -- name: CreateTripLink :one
 INSERT INTO links
@@ -72,7 +270,76 @@ RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "version"
 FROM links
 WHERE
     trip_id = $1;
+
+-- name: GetTripLink :one
+SELECT
+    "id", "trip_id", "title", "url", "version"
+FROM links
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: UpdateTripLink :one
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2,
+    "version" = "version" + 1
+WHERE
+    id = $3
+    AND trip_id = $4
+    AND "version" = $5
+RETURNING "id", "trip_id", "title", "url", "version";
+
+-- name: DeleteTripLink :execrows
+DELETE FROM links
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: EnqueueEmail :exec
+INSERT INTO email_outbox
+    ( "kind", "trip_id", "participant_id", "email" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: ClaimEmails :many
+UPDATE email_outbox
+SET
+    "attempts" = "attempts" + 1,
+    "next_attempt_at" = sqlc.arg(lease_until)
+WHERE
+    id IN (
+        SELECT id
+        FROM email_outbox
+        WHERE
+            "status" = 'pending'
+            AND "next_attempt_at" <= now()
+        ORDER BY "next_attempt_at", "id"
+        LIMIT sqlc.arg(batch_size)
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING
+    "id", "kind", "trip_id", "participant_id", "email", "status", "attempts",
+    "last_error", "next_attempt_at", "created_at", "sent_at";
+
+-- name: MarkEmailSent :exec
+UPDATE email_outbox
+SET
+    "status" = 'sent',
+    "last_error" = NULL,
+    "sent_at" = now()
+WHERE
+    id = $1;
+
+-- name: MarkEmailFailed :exec
+UPDATE email_outbox
+SET
+    "status" = $1,
+    "last_error" = $2,
+    "next_attempt_at" = $3
+WHERE
+    id = $4;
@@ -22,14 +22,26 @@ func (q *Queries) CreateTrip(ctx context.Context,pool *pgxpool.Pool, params spec
 
     qtx := q.WithTx(tx)
 
+    timeZone := "UTC"
+    if params.TimeZone != nil {
+        timeZone = *params.TimeZone
+    }
+
+    locale := LocalePtBR
+    if params.Locale != nil {
+        locale = Locale(params.Locale.ToValue())
+    }
+
     tripID,err:= qtx.InsertTrip(
         ctx,
         InsertTripParams{
             Destination: params.Destination,
             OwnerEmail: string(params.OwnerEmail),
             OwnerName: params.OwnerName,
-            StartsAt: pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-            EndsAt: pgtype.Timestamp{Valid: true, Time: params.EndsAt,},
+            StartsAt: pgtype.Timestamptz{Valid: true, Time: params.StartsAt},
+            EndsAt: pgtype.Timestamptz{Valid: true, Time: params.EndsAt,},
+            TimeZone: timeZone,
+            Locale: locale,
         },
     )
 
@@ -49,6 +61,10 @@ func (q *Queries) CreateTrip(ctx context.Context,pool *pgxpool.Pool, params spec
         return id,fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w",err)
     }
 
+    if err := qtx.EnqueueEmail(ctx,TripEmail(EmailKindTripCreated,tripID)); err != nil{
+        return id,fmt.Errorf("pgstore: failed to queue email for CreateTrip: %w",err)
+    }
+
 
     if err:= tx.Commit(ctx); err != nil {
         return id,fmt.Errorf("pgstore: failed to commit tx for CreateTrip: %w",err)
@@ -56,3 +72,81 @@ func (q *Queries) CreateTrip(ctx context.Context,pool *pgxpool.Pool, params spec
 
     return tripID,nil
 }
+
+func (q *Queries) CreateActivities(ctx context.Context,pool *pgxpool.Pool, params []CreateActivityParams)([]uuid.UUID,error){
+    tx, err := pool.Begin(ctx)
+    if err != nil {
+        return nil,fmt.Errorf("pgstore: failed to begin trx for CreateActivities: %w",err)
+    }
+
+    defer func(){tx.Rollback(ctx)}()
+
+    qtx := q.WithTx(tx)
+
+    // COPY can't return the generated ids, so they are set here in input order.
+    ids := make([]uuid.UUID,len(params))
+    activities := make([]InsertActivitiesParams,len(params))
+    for i, p := range params{
+        ids[i] = uuid.New()
+        activities[i] = InsertActivitiesParams{
+            ID: ids[i],
+            TripID: p.TripID,
+            Title: p.Title,
+            OccursAt: p.OccursAt,
+            TimeZone: p.TimeZone,
+            EndsAt: p.EndsAt,
+            Location: p.Location,
+            Category: p.Category,
+            Address: p.Address,
+            Latitude: p.Latitude,
+            Longitude: p.Longitude,
+            BookingReference: p.BookingReference,
+            CostAmount: p.CostAmount,
+            CostCurrency: p.CostCurrency,
+            RecurrenceFrequency: p.RecurrenceFrequency,
+            RecurrenceInterval: p.RecurrenceInterval,
+            RecurrenceWeekdays: p.RecurrenceWeekdays,
+            RecurrenceUntil: p.RecurrenceUntil,
+        }
+    }
+
+    if _, err := qtx.InsertActivities(ctx,activities); err != nil{
+        return nil,fmt.Errorf("pgstore: failed to insert activities for CreateActivities: %w",err)
+    }
+
+    if err:= tx.Commit(ctx); err != nil {
+        return nil,fmt.Errorf("pgstore: failed to commit tx for CreateActivities: %w",err)
+    }
+
+    return ids,nil
+}
+
+// WithEmails runs fn in a transaction and queues the e-mails it returns in
+// the same one, so e-mails are only sent for changes that were committed.
+func (q *Queries) WithEmails(ctx context.Context,pool *pgxpool.Pool, fn func(*Queries)([]EnqueueEmailParams,error)) error{
+    tx, err := pool.Begin(ctx)
+    if err != nil {
+        return fmt.Errorf("pgstore: failed to begin trx for WithEmails: %w",err)
+    }
+
+    defer func(){tx.Rollback(ctx)}()
+
+    qtx := q.WithTx(tx)
+
+    emails, err := fn(qtx)
+    if err != nil {
+        return err
+    }
+
+    for _, email := range emails{
+        if err := qtx.EnqueueEmail(ctx,email); err != nil{
+            return fmt.Errorf("pgstore: failed to queue email for WithEmails: %w",err)
+        }
+    }
+
+    if err:= tx.Commit(ctx); err != nil {
+        return fmt.Errorf("pgstore: failed to commit tx for WithEmails: %w",err)
+    }
+
+    return nil
+}
@@ -0,0 +1,28 @@
+package pgstore
+
+import "time"
+
+// Zone returns the time zone of the trip. Names are validated before
+// being stored, so UTC is only used if the server's time zone database
+// doesn't know one anymore.
+func (t Trip) Zone() *time.Location {
+	loc, err := time.LoadLocation(t.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Zone returns the time zone of the activity, which defaults to the
+// time zone of its trip.
+func (a Activity) Zone(trip Trip) *time.Location {
+	if !a.TimeZone.Valid {
+		return trip.Zone()
+	}
+
+	loc, err := time.LoadLocation(a.TimeZone.String)
+	if err != nil {
+		return trip.Zone()
+	}
+	return loc
+}